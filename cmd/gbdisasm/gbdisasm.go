@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/disasm"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+func main() {
+	var outputPath, symbolsPath string
+
+	flag.StringVar(&outputPath, "o", "", "write the disassembly to path instead of stdout")
+	flag.StringVar(&symbolsPath, "sym", "", "symbol file path (default <rom>.sym if present)")
+
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), "Usage: gbdisasm [options] rom\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+
+	var syms *symbols.Table
+	if symbolsPath != "" {
+		syms, err = symbols.Load(symbolsPath)
+	} else {
+		syms, err = symbols.LoadForROM(flag.Arg(0))
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	var w io.Writer = os.Stdout
+
+	if outputPath != "" {
+		file, err := os.Create(outputPath)
+		if err != nil {
+			panic(err)
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	err = disasm.DisassembleROM(data, syms).Write(w)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -5,34 +5,79 @@ import (
 	"fmt"
 	"github.com/adnsio/gbemu/internal/renderer"
 	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/debugger"
+	"github.com/adnsio/gbemu/pkg/gameboy/gdb"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/serial"
+	"github.com/adnsio/gbemu/pkg/gameboy/link"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/movie"
+	"github.com/adnsio/gbemu/pkg/gameboy/printer"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+	"github.com/adnsio/gbemu/pkg/gameboy/trace"
+	"io"
 	"io/ioutil"
 	"os"
+	"os/signal"
 )
 
-func loadFileData(path string) []uint8 {
+func loadFileData(path string) ([]uint8, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	defer file.Close()
 
-	data, err := ioutil.ReadAll(file)
-	if err != nil {
-		panic(err)
-	}
+	return ioutil.ReadAll(file)
+}
 
-	return data
+// exit prints an error the user can fix, as a missing or invalid rom, and
+// exits.
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "gbemu: %v\n", err)
+	os.Exit(1)
 }
 
 func main() {
 	var bootromPath, cartridgePath string
-	var debugWindows bool
-	//var maxFramesPerSecond int
+	var linkListen, linkConnect string
+	var printerDir string
+	var rewindInterval, rewindSeconds int
+	var recordPath, recordStatePath, playPath string
+	var symbolsPath string
+	var gdbListen string
+	var logFilter string
+	var tracePath, traceStart, traceStop, traceRange string
+	var traceSymbols bool
+	var debugWindows, serialOutput, debugMode bool
+	var frontend, framesDir string
+	var maxFramesPerSecond, maxFrames, framesEvery int
 
 	flag.StringVar(&bootromPath, "bootrom", "assets/bios/dmg_boot.bin", "bootrom path")
 	flag.StringVar(&cartridgePath, "cartridge", "assets/roms/tetris.gb", "cartridge path")
 	flag.BoolVar(&debugWindows, "debug-windows", true, "enabled debug windows")
-	//flag.IntVar(&maxFramesPerSecond, "max-fps", 60, "max frames per second")
+	flag.StringVar(&linkListen, "link-listen", "", "wait for a link cable connection on address (e.g. :5000)")
+	flag.StringVar(&linkConnect, "link-connect", "", "connect the link cable to address (e.g. localhost:5000)")
+	flag.StringVar(&printerDir, "printer", "", "connect a game boy printer saving prints in directory")
+	flag.IntVar(&rewindInterval, "rewind-interval", 2, "frames between rewind snapshots (0 disables rewind)")
+	flag.IntVar(&rewindSeconds, "rewind-seconds", 60, "seconds of rewind history")
+	flag.StringVar(&recordPath, "record", "", "record an input movie to path")
+	flag.StringVar(&recordStatePath, "record-state", "", "start recording from the save state at path instead of power-on")
+	flag.StringVar(&playPath, "play", "", "play the input movie at path")
+	flag.StringVar(&symbolsPath, "sym", "", "symbol file path (default <cartridge>.sym if present)")
+	flag.StringVar(&tracePath, "trace", "", "write a Gameboy Doctor instruction trace to path")
+	flag.StringVar(&traceStart, "trace-start", "", "start tracing at frame:N or pc:XXXX")
+	flag.StringVar(&traceStop, "trace-stop", "", "stop tracing at frame:N or pc:XXXX")
+	flag.StringVar(&traceRange, "trace-range", "", "trace only the instructions between two addresses (e.g. 0100-7fff)")
+	flag.BoolVar(&traceSymbols, "trace-symbols", false, "append the symbols to the trace lines")
+	flag.BoolVar(&debugMode, "debug", false, "run in the command line debugger instead of the window")
+	flag.StringVar(&gdbListen, "gdb", "", "wait for a gdb remote debugger connection on address instead of opening the window (e.g. localhost:2159)")
+	flag.BoolVar(&serialOutput, "serial-output", false, "print the data sent on the serial port when exiting")
+	flag.StringVar(&logFilter, "log", "", "log the subsystems cpu, mem, ppu, apu, cart, serial, gb or all, each optionally with the lowest level debug, info, warn or error (e.g. mem,cart:debug)")
+	flag.StringVar(&frontend, "frontend", "sdl", "show the game boy with sdl, in the terminal, or headless without a window")
+	flag.IntVar(&maxFramesPerSecond, "max-fps", -1, "max frames per second, 0 is unlimited (default 60, unlimited headless)")
+	flag.IntVar(&maxFrames, "frames", 0, "exit after the given number of frames (0 runs until quit)")
+	flag.StringVar(&framesDir, "frames-dir", "", "headless: write the frames as png files to directory")
+	flag.IntVar(&framesEvery, "frames-every", 1, "headless: number of frames between the frames written")
 
 	flag.Usage = func() {
 		fmt.Fprint(flag.CommandLine.Output(), "Usage: gbemu [options]\n")
@@ -43,25 +88,282 @@ func main() {
 
 	gbCfg := gameboy.Config{}
 
+	// rewinding would break the input sequence of movies
+	if rewindInterval > 0 && recordPath == "" && playPath == "" {
+		gbCfg.RewindInterval = rewindInterval
+		gbCfg.RewindDepth = rewindSeconds * 60 / rewindInterval
+	}
+
 	// testing
 	//bootromPath = ""
 	//cartridgePath = "assets/test_roms/cpu_instrs.gb"
 	//cartridgePath = "assets/test_roms/cpu_instrs-individual/06-ld r,r.gb"
 
+	var err error
+
+	if logFilter != "" {
+		filter, err := logger.ParseFilter(logFilter)
+		if err != nil {
+			exit(err)
+		}
+
+		logWriter := logger.NewWriter(os.Stderr, filter)
+		defer logWriter.Flush()
+
+		gbCfg.Logger = logWriter
+	}
+
 	if bootromPath != "" {
-		gbCfg.Bootrom = loadFileData(bootromPath)
+		gbCfg.Bootrom, err = loadFileData(bootromPath)
+		if err != nil {
+			exit(err)
+		}
 	}
 
 	if cartridgePath != "" {
-		gbCfg.Cartridge = loadFileData(cartridgePath)
+		gbCfg.Cartridge, err = loadFileData(cartridgePath)
+		if err != nil {
+			exit(err)
+		}
+	}
+
+	gb, err := gameboy.NewGameBoy(gbCfg)
+	if err != nil {
+		exit(err)
+	}
+
+	var syms *symbols.Table
+
+	if symbolsPath != "" {
+		syms, err = symbols.Load(symbolsPath)
+	} else if cartridgePath != "" {
+		syms, err = symbols.LoadForROM(cartridgePath)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	if tracePath != "" {
+		traceCfg := trace.Config{}
+
+		if traceStart != "" {
+			traceCfg.Start, err = trace.ParseCondition(traceStart)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		if traceStop != "" {
+			traceCfg.Stop, err = trace.ParseCondition(traceStop)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		if traceRange != "" {
+			_, err := fmt.Sscanf(traceRange, "%x-%x", &traceCfg.MinPC, &traceCfg.MaxPC)
+			if err != nil {
+				panic(fmt.Errorf("invalid trace range %q", traceRange))
+			}
+		}
+
+		if traceSymbols {
+			traceCfg.Symbols = syms
+		}
+
+		traceFile, err := os.Create(tracePath)
+		if err != nil {
+			panic(err)
+		}
+		defer traceFile.Close()
+
+		tracer := trace.NewTracer(traceFile, traceCfg)
+		defer tracer.Flush()
+
+		gb.Tracer = tracer
+	}
+
+	if linkListen != "" || linkConnect != "" {
+		var lnk *link.Link
+		var err error
+
+		if linkListen != "" {
+			lnk, err = link.Listen(linkListen)
+		} else {
+			lnk, err = link.Dial(linkConnect)
+		}
+		if err != nil {
+			panic(err)
+		}
+		defer lnk.Close()
+
+		gb.Hardware.Serial.Connect(lnk)
+	} else if printerDir != "" {
+		prt := printer.NewPrinter(printerDir)
+		prt.Log = gb.Log
+		defer func() {
+			if err := prt.Flush(); err != nil {
+				fmt.Fprintf(os.Stderr, "gbemu: %v\n", err)
+			}
+		}()
+
+		gb.Hardware.Serial.Connect(prt)
+	}
+
+	runFrame := gb.RunFrame
+
+	// rdr is created with the backend, the frames only run from it
+	var rdr *renderer.Renderer
+
+	if recordPath != "" {
+		if recordStatePath != "" {
+			stateFile, err := os.Open(recordStatePath)
+			if err != nil {
+				panic(err)
+			}
+
+			err = gb.LoadState(stateFile)
+			stateFile.Close()
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		movieFile, err := os.Create(recordPath)
+		if err != nil {
+			panic(err)
+		}
+		defer movieFile.Close()
+
+		rec, err := movie.NewRecorder(movieFile, gb, gbCfg.Cartridge, recordStatePath != "")
+		if err != nil {
+			panic(err)
+		}
+		defer rec.Flush()
+
+		runFrame = func() error {
+			return rec.Frame(gb)
+		}
+	} else if playPath != "" {
+		movieFile, err := os.Open(playPath)
+		if err != nil {
+			panic(err)
+		}
+		defer movieFile.Close()
+
+		player, err := movie.NewPlayer(movieFile)
+		if err != nil {
+			panic(err)
+		}
+
+		err = player.Start(gb, gbCfg.Cartridge)
+		if err != nil {
+			panic(err)
+		}
+
+		playing := true
+
+		runFrame = func() error {
+			if !playing {
+				return gb.RunFrame()
+			}
+
+			err := player.Frame(gb)
+			switch err.(type) {
+			case nil:
+			case *movie.DesyncError:
+				rdr.Messagef("%v", err)
+			default:
+				if err != io.EOF {
+					return err
+				}
+
+				playing = false
+
+				if player.Desync == 0 {
+					rdr.Messagef("movie: playback ended without desync")
+				} else {
+					rdr.Messagef("movie: playback ended, first desync at frame %d", player.Desync)
+				}
+			}
+
+			return nil
+		}
 	}
 
-	gb := gameboy.NewGameBoy(gbCfg)
+	if serialOutput {
+		serialBuffer := serial.NewBuffer()
+		gb.Hardware.SetSerialSink(serialBuffer)
 
-	rdr := renderer.NewRenderer(renderer.Config{
+		defer func() {
+			fmt.Println(serialBuffer.String())
+		}()
+	}
+
+	if debugMode {
+		dbg := debugger.NewDebugger(gb, os.Stdin, os.Stdout)
+		dbg.Symbols = syms
+
+		interrupts := make(chan os.Signal, 1)
+		signal.Notify(interrupts, os.Interrupt)
+		go func() {
+			for range interrupts {
+				dbg.Interrupt()
+			}
+		}()
+
+		dbg.Run()
+		return
+	}
+
+	if gdbListen != "" {
+		fmt.Printf("gdb: waiting for a connection on %s\n", gdbListen)
+
+		err := gdb.NewServer(gb).ListenAndServe(gdbListen)
+		if err != nil {
+			panic(err)
+		}
+
+		return
+	}
+
+	var backend renderer.Backend
+
+	switch frontend {
+	case "sdl":
+		backend = renderer.NewSDL(debugWindows)
+
+		if maxFramesPerSecond < 0 {
+			maxFramesPerSecond = 60
+		}
+	case "terminal":
+		backend = renderer.NewTerminal(os.Stdin, os.Stdout)
+
+		if maxFramesPerSecond < 0 {
+			maxFramesPerSecond = 60
+		}
+	case "headless":
+		backend = renderer.NewHeadless(framesDir, framesEvery)
+
+		if maxFramesPerSecond < 0 {
+			maxFramesPerSecond = 0
+		}
+	default:
+		exit(fmt.Errorf("invalid frontend %q (want sdl, terminal or headless)", frontend))
+	}
+
+	rdr = renderer.NewRenderer(renderer.Config{
+		Backend:      backend,
 		GameBoy:      gb,
 		DebugWindows: debugWindows,
+		StatePath:    cartridgePath,
+		RunFrame:     runFrame,
+		FrameRate:    maxFramesPerSecond,
+		MaxFrames:    maxFrames,
+		StopOnError:  frontend == "headless",
 	})
 
-	rdr.Run()
+	if err := rdr.Run(); err != nil {
+		exit(err)
+	}
 }
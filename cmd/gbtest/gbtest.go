@@ -0,0 +1,263 @@
+package main
+
+import (
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/golden"
+	"github.com/adnsio/gbemu/pkg/gameboy/testrom"
+)
+
+// protocolScreenshot compares the screen with a reference image, see golden.Check
+const protocolScreenshot = "screenshot"
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+type romResult struct {
+	Path string
+	testrom.Result
+}
+
+func findROMs(paths []string) ([]string, error) {
+	var roms []string
+
+	for _, path := range paths {
+		err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			ext := strings.ToLower(filepath.Ext(p))
+			if !info.IsDir() && (p == path || ext == ".gb" || ext == ".gbc") {
+				roms = append(roms, p)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return roms, nil
+}
+
+func checkScreenshot(path string, bootrom []uint8) (res testrom.Result) {
+	start := time.Now()
+
+	defer func() {
+		if err := recover(); err != nil {
+			res.Status = testrom.StatusError
+			res.Message = fmt.Sprint(err)
+		}
+
+		res.Duration = time.Since(start)
+	}()
+
+	err := golden.Check(path, bootrom)
+
+	switch err := err.(type) {
+	case nil:
+		res.Status = testrom.StatusPass
+	case *golden.MismatchError:
+		res.Status = testrom.StatusFail
+		res.Message = err.Error()
+		res.Frames = err.Frames
+	default:
+		res.Status = testrom.StatusError
+		res.Message = err.Error()
+	}
+
+	return res
+}
+
+func runROMs(roms []string, cfg testrom.Config, jobs int) []romResult {
+	results := make([]romResult, len(roms))
+	indexes := make(chan int)
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				results[i].Path = roms[i]
+
+				if cfg.Protocol == protocolScreenshot {
+					results[i].Result = checkScreenshot(roms[i], cfg.Bootrom)
+					continue
+				}
+
+				rom, err := ioutil.ReadFile(roms[i])
+				if err != nil {
+					results[i].Status = testrom.StatusError
+					results[i].Message = err.Error()
+					continue
+				}
+
+				results[i].Result = testrom.Run(rom, cfg)
+			}
+		}()
+	}
+
+	for i := range roms {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return results
+}
+
+func writeJUnit(path string, results []romResult, elapsed time.Duration) error {
+	suite := junitTestSuite{
+		Name:  "gbtest",
+		Tests: len(results),
+		Time:  fmt.Sprintf("%.3f", elapsed.Seconds()),
+	}
+
+	for _, res := range results {
+		tc := junitTestCase{
+			Name:      filepath.Base(res.Path),
+			ClassName: filepath.Dir(res.Path),
+			Time:      fmt.Sprintf("%.3f", res.Duration.Seconds()),
+		}
+
+		msg := &junitMessage{
+			Message: fmt.Sprintf("%s after %d frames", res.Status, res.Frames),
+			Type:    res.Status.String(),
+			Body:    res.Message,
+		}
+
+		switch res.Status {
+		case testrom.StatusFail, testrom.StatusTimeout:
+			tc.Failure = msg
+			suite.Failures++
+		case testrom.StatusError:
+			tc.Error = msg
+			suite.Errors++
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append([]byte(xml.Header), data...), 0644)
+}
+
+func main() {
+	var bootromPath, junitPath string
+	var jobs int
+	var verbose bool
+
+	cfg := testrom.Config{}
+
+	flag.StringVar(&cfg.Protocol, "protocol", testrom.ProtocolAuto, "result protocol: auto, serial, memory, mooneye or screenshot")
+	flag.IntVar(&cfg.MaxFrames, "frames", testrom.DefaultMaxFrames, "timeout in frames")
+	flag.IntVar(&cfg.MaxCycles, "cycles", 0, "timeout in cycles (0 disabled)")
+	flag.StringVar(&bootromPath, "bootrom", "", "bootrom path")
+	flag.IntVar(&jobs, "j", runtime.NumCPU(), "roms run in parallel")
+	flag.StringVar(&junitPath, "junit", "", "write a JUnit XML report to path")
+	flag.BoolVar(&verbose, "v", false, "print the output of every rom")
+
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), "Usage: gbtest [options] rom|directory...\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if bootromPath != "" {
+		bootrom, err := ioutil.ReadFile(bootromPath)
+		if err != nil {
+			panic(err)
+		}
+
+		cfg.Bootrom = bootrom
+	}
+
+	roms, err := findROMs(flag.Args())
+	if err != nil {
+		panic(err)
+	}
+
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	start := time.Now()
+	results := runROMs(roms, cfg, jobs)
+	elapsed := time.Since(start)
+
+	counts := map[testrom.Status]int{}
+
+	for _, res := range results {
+		counts[res.Status]++
+
+		fmt.Printf("%-7s %s (%d frames, %s)\n", res.Status, res.Path, res.Frames, res.Duration.Round(time.Millisecond))
+
+		if res.Message != "" && (verbose || res.Status != testrom.StatusPass) {
+			fmt.Printf("        %s\n", strings.Replace(res.Message, "\n", "\n        ", -1))
+		}
+	}
+
+	fmt.Printf("\n%d roms, %d passed, %d failed, %d timed out, %d errors in %s\n",
+		len(results), counts[testrom.StatusPass], counts[testrom.StatusFail], counts[testrom.StatusTimeout], counts[testrom.StatusError], elapsed.Round(time.Millisecond))
+
+	if junitPath != "" {
+		err := writeJUnit(junitPath, results, elapsed)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if counts[testrom.StatusPass] != len(results) {
+		os.Exit(1)
+	}
+}
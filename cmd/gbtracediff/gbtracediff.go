@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/trace"
+)
+
+func printLines(prefix string, lines []trace.Line) {
+	for _, line := range lines {
+		fmt.Printf("%s %8d  %s\n", prefix, line.Number, line.Text)
+	}
+}
+
+func main() {
+	var alignPC, ignore string
+	var context int
+
+	flag.StringVar(&alignPC, "align", "0100", "skip the lines before the first one at this address in both logs (empty to compare from the start)")
+	flag.StringVar(&ignore, "ignore", "", "comma separated fields not compared (e.g. CY,PCMEM)")
+	flag.IntVar(&context, "context", 5, "lines shown before and after the difference")
+
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), "Usage: gbtracediff [options] ours reference\n")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg := trace.DiffConfig{Context: context}
+
+	if alignPC != "" {
+		pc, err := strconv.ParseUint(strings.TrimPrefix(alignPC, "$"), 16, 16)
+		if err != nil {
+			panic(fmt.Errorf("gbtracediff: invalid address %q", alignPC))
+		}
+
+		cfg.AlignPC = uint16(pc)
+		cfg.HasAlignPC = true
+	}
+
+	if ignore != "" {
+		cfg.Ignore = strings.Split(ignore, ",")
+	}
+
+	ours, err := os.Open(flag.Arg(0))
+	if err != nil {
+		panic(err)
+	}
+	defer ours.Close()
+
+	reference, err := os.Open(flag.Arg(1))
+	if err != nil {
+		panic(err)
+	}
+	defer reference.Close()
+
+	res, err := trace.Diff(ours, reference, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "gbtracediff: %v\n", err)
+		os.Exit(2)
+	}
+
+	if len(res.Differences) == 0 {
+		fmt.Printf("no differences in %d lines\n", res.Compared)
+		return
+	}
+
+	fmt.Printf("first difference after %d equal lines, at line %d of %s and line %d of %s:\n",
+		res.Compared, res.A.Number, flag.Arg(0), res.B.Number, flag.Arg(1))
+	for _, diff := range res.Differences {
+		fmt.Printf("    %s\n", diff)
+	}
+
+	fmt.Println()
+	printLines(" ", res.Before)
+	if res.A.Text != "" {
+		printLines("<", []trace.Line{res.A})
+	}
+	if res.B.Text != "" {
+		printLines(">", []trace.Line{res.B})
+	}
+	printLines("<", res.AfterA)
+	printLines(">", res.AfterB)
+
+	os.Exit(1)
+}
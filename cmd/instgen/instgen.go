@@ -1,25 +1,44 @@
+// Command instgen generates the cpu instruction tables from opcodes.json, it's
+// run by go generate in the cpu package:
+//
+//	go generate ./pkg/gameboy/cpu
+//
+// It writes the instruction metadata, the cycle tables and the dispatch
+// tables to instructions.go and a test per opcode to instructions_test.go.
+// An instruction is dispatched to the handler builder named after it, as
+// ldInst for LD, found in the package sources, or to invalidInst if there
+// is none.
 package main
 
 import (
+	"bytes"
+	_ "embed"
 	"encoding/json"
+	"flag"
 	"fmt"
-	"os"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
 	"strings"
 )
 
-type OpCode struct {
-	Name           string            `json:"name"`
-	Description    string            `json:"description"`
-	Parameters     []string          `json:"parameters"`
-	Flags          map[string]string `json:"flags"`
-	Bits           int               `json:"bits"`
-	CyclesBranch   int               `json:"cycles_branch"`
-	CyclesNoBranch int               `json:"cycles_no_branch"`
-}
+//go:embed opcodes.json
+var opCodesJSON []byte
 
-type OpCodes struct {
-	Unprefixed map[string]OpCode `json:"unprefixed"`
-	CBPrefixed map[string]OpCode `json:"cb_prefixed"`
+const header = "// Code generated by instgen from opcodes.json. DO NOT EDIT.\n\n"
+
+type OpCode struct {
+	Name           string
+	Description    string
+	Parameters     []string
+	Flags          map[string]string
+	Bits           int
+	CyclesBranch   int
+	CyclesNoBranch int
 }
 
 type InputOpCode struct {
@@ -43,8 +62,7 @@ func parseInputOpCode(inputOpCode InputOpCode) OpCode {
 
 	if len(nameSplitSpace) > 1 {
 		name = nameSplitSpace[0]
-		nameSplitComma := strings.Split(nameSplitSpace[1], ",")
-		params = nameSplitComma
+		params = strings.Split(nameSplitSpace[1], ",")
 	} else {
 		name = inputOpCode.Name
 	}
@@ -54,8 +72,6 @@ func parseInputOpCode(inputOpCode InputOpCode) OpCode {
 		bits = 16
 	}
 
-	fmt.Println(name, params)
-
 	return OpCode{
 		Name:           name,
 		Description:    inputOpCode.Name,
@@ -67,125 +83,209 @@ func parseInputOpCode(inputOpCode InputOpCode) OpCode {
 	}
 }
 
-func generateInstructionString(opCode string, opCodeData OpCode) string {
-	parametersString := ""
-
-	for _, parameter := range opCodeData.Parameters {
-		parametersString += fmt.Sprintf("\"%s\",", parameter)
-		/*if i+1 != len(opCodeData.Parameters) {
-			parametersString += ","
-		}*/
+func parseOpCodes(input []InputOpCode) ([]OpCode, error) {
+	if len(input) != 256 {
+		return nil, fmt.Errorf("instgen: want 256 opcodes, got %d", len(input))
 	}
 
-	flagsString := ""
-
-	for key, flag := range opCodeData.Flags {
-		flagsString += fmt.Sprintf("\"%s\":\"%s\",", key, flag)
-		/*if i+1 != len(opCodeData.Parameters) {
-			parametersString += ","
-		}*/
+	res := make([]OpCode, len(input))
+	for i, inputOpCode := range input {
+		res[i] = parseInputOpCode(inputOpCode)
 	}
 
-	return fmt.Sprintf("%s: {OpCode: %s, Name: \"%s\", Description: \"%s\", Parameters: []string{%s}, Flags: map[string]string{%s}, Bits: %d, CyclesBranch: %d, CyclesNoBranch: %d }", opCode, opCode, opCodeData.Name, opCodeData.Description, parametersString, flagsString, opCodeData.Bits, opCodeData.CyclesBranch, opCodeData.CyclesNoBranch)
+	return res, nil
 }
 
-func main() {
-	inputFile, err := os.Open("input.json")
+// handlerName returns the name of the handler builder of an instruction.
+func handlerName(name string) string {
+	return strings.ToLower(name) + "Inst"
+}
+
+// findHandlers returns the names of the functions declared in the package in
+// dir, the generated files excluded.
+func findHandlers(dir string, generated ...string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, dir, nil, 0)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	defer inputFile.Close()
 
-	inputFileStat, err := inputFile.Stat()
-	if err != nil {
-		panic(err)
+	skip := make(map[string]bool)
+	for _, name := range generated {
+		skip[filepath.Join(dir, name)] = true
 	}
 
-	inputFileBytes := make([]byte, inputFileStat.Size())
+	res := make(map[string]bool)
 
-	_, err = inputFile.Read(inputFileBytes)
-	if err != nil {
-		panic(err)
+	for _, pkg := range pkgs {
+		for path, file := range pkg.Files {
+			if skip[path] || strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+					res[fn.Name.Name] = true
+				}
+			}
+		}
 	}
 
-	err = inputFile.Close()
-	if err != nil {
-		panic(err)
+	return res, nil
+}
+
+func writeInstructions(buf *bytes.Buffer, name string, opCodes []OpCode) {
+	fmt.Fprintf(buf, "var %s = map[uint8]*Instruction{\n", name)
+
+	for i, op := range opCodes {
+		var params []string
+		for _, param := range op.Parameters {
+			params = append(params, fmt.Sprintf("%q", param))
+		}
+
+		var keys []string
+		for key := range op.Flags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var flags []string
+		for _, key := range keys {
+			flags = append(flags, fmt.Sprintf("%q: %q", key, op.Flags[key]))
+		}
+
+		fmt.Fprintf(buf, "%#02x: {OpCode: %#02x, Name: %q, Description: %q, Parameters: []string{%s}, Flags: map[string]string{%s}, Bits: %d, CyclesBranch: %d, CyclesNoBranch: %d},\n",
+			i, i, op.Name, op.Description, strings.Join(params, ", "), strings.Join(flags, ", "), op.Bits, op.CyclesBranch, op.CyclesNoBranch)
 	}
 
-	var inputOpCodes InputOpCodes
-	err = json.Unmarshal(inputFileBytes, &inputOpCodes)
-	if err != nil {
-		panic(err)
+	buf.WriteString("}\n\n")
+}
+
+func writeCycles(buf *bytes.Buffer, name string, opCodes []OpCode, branch bool) {
+	fmt.Fprintf(buf, "var %s = [256]int{\n", name)
+
+	for i, op := range opCodes {
+		if i%16 == 0 {
+			fmt.Fprintf(buf, "/* %#02x */ ", i)
+		}
+
+		cycles := op.CyclesNoBranch
+		if branch {
+			cycles = op.CyclesBranch
+		}
+
+		fmt.Fprintf(buf, "%d,", cycles)
+
+		if i%16 == 15 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
 	}
 
-	unprefixedOps := make(map[string]OpCode)
-	for opCodeNumber, inputOpCode := range inputOpCodes.Unprefixed {
-		opCodeHex := fmt.Sprintf("%#02x", opCodeNumber)
-		fmt.Printf("%s; %s\n", opCodeHex, inputOpCode.Name)
+	buf.WriteString("}\n\n")
+}
+
+func writeHandlers(buf *bytes.Buffer, name string, instructions string, opCodes []OpCode, handlers map[string]bool) {
+	fmt.Fprintf(buf, "var %s = [256]handler{\n", name)
 
-		opCode := parseInputOpCode(inputOpCode)
+	for i, op := range opCodes {
+		builder := handlerName(op.Name)
+		if !handlers[builder] {
+			builder = "invalidInst"
+		}
 
-		unprefixedOps[opCodeHex] = opCode
+		fmt.Fprintf(buf, "%#02x: %s(%s[%#02x]),\n", i, builder, instructions, i)
 	}
 
-	cbPrefixedOps := make(map[string]OpCode)
-	for opCodeNumber, inputOpCode := range inputOpCodes.CBPrefixed {
-		opCodeHex := fmt.Sprintf("%#02x", opCodeNumber)
-		fmt.Printf("%s; %s\n", opCodeHex, inputOpCode.Name)
+	buf.WriteString("}\n\n")
+}
+
+func writeTests(buf *bytes.Buffer, prefix string, prefixed bool, opCodes []OpCode, handlers map[string]bool) {
+	for i, op := range opCodes {
+		fmt.Fprintf(buf, "// %s\nfunc Test%s%02X(t *testing.T) {\n", op.Description, prefix, i)
 
-		opCode := parseInputOpCode(inputOpCode)
+		if handlers[handlerName(op.Name)] {
+			fmt.Fprintf(buf, "testOpCode(t, %t, %#02x)\n", prefixed, i)
+		} else {
+			fmt.Fprintf(buf, "t.Skip(%q)\n", op.Name+" not implemented")
+		}
 
-		cbPrefixedOps[opCodeHex] = opCode
+		buf.WriteString("}\n\n")
 	}
+}
 
-	opCodes := OpCodes{
-		Unprefixed: unprefixedOps,
-		CBPrefixed: cbPrefixedOps,
+func writeSource(path string, buf *bytes.Buffer) error {
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("instgen: %s: %v", path, err)
 	}
 
-	opCodesBytes, err := json.Marshal(opCodes)
+	return ioutil.WriteFile(path, src, 0644)
+}
+
+func main() {
+	var dir, pkgName, output, testOutput string
+
+	flag.StringVar(&dir, "dir", ".", "cpu package directory")
+	flag.StringVar(&pkgName, "package", "cpu", "package name")
+	flag.StringVar(&output, "o", "instructions.go", "tables file name")
+	flag.StringVar(&testOutput, "test", "instructions_test.go", "tests file name")
+
+	flag.Parse()
+
+	var inputOpCodes InputOpCodes
+	err := json.Unmarshal(opCodesJSON, &inputOpCodes)
 	if err != nil {
 		panic(err)
 	}
 
-	outputFile, err := os.Create("output.json")
+	unprefixed, err := parseOpCodes(inputOpCodes.Unprefixed)
 	if err != nil {
 		panic(err)
 	}
-	defer outputFile.Close()
 
-	_, err = outputFile.Write(opCodesBytes)
+	cbPrefixed, err := parseOpCodes(inputOpCodes.CBPrefixed)
 	if err != nil {
 		panic(err)
 	}
 
-	fmt.Printf("%+v\n", opCodes)
+	handlers, err := findHandlers(dir, output, testOutput)
+	if err != nil {
+		panic(err)
+	}
 
-	var goInstructions string
-	var goPrefixedInstructions string
+	var buf bytes.Buffer
 
-	for opCode, opCodeData := range opCodes.Unprefixed {
-		inst := generateInstructionString(opCode, opCodeData)
-		goInstructions += fmt.Sprintf("%s,\n", inst)
-	}
+	fmt.Fprintf(&buf, "%spackage %s\n\n", header, pkgName)
 
-	for opCode, opCodeData := range opCodes.CBPrefixed {
-		inst := generateInstructionString(opCode, opCodeData)
-		goPrefixedInstructions += fmt.Sprintf("%s,\n", inst)
-	}
+	writeInstructions(&buf, "Instructions", unprefixed)
+	writeInstructions(&buf, "PrefixedInstructions", cbPrefixed)
 
-	goInstructionsFileContent := fmt.Sprintf("var Instructions = map[uint8]*Instruction{\n%s}\n\nvar PrefixedInstructions = map[uint8]*Instruction{\n%s}\n", goInstructions, goPrefixedInstructions)
+	buf.WriteString("// InstructionCycles are the cycles of the instructions by opcode, when the\n// branch is taken.\n")
+	writeCycles(&buf, "InstructionCycles", unprefixed, true)
+	buf.WriteString("// InstructionCyclesNoBranch are the cycles of the instructions by opcode,\n// when the branch isn't taken.\n")
+	writeCycles(&buf, "InstructionCyclesNoBranch", unprefixed, false)
+	buf.WriteString("// PrefixedInstructionCycles are the cycles of the prefixed instructions by\n// opcode.\n")
+	writeCycles(&buf, "PrefixedInstructionCycles", cbPrefixed, true)
 
-	fmt.Println(goInstructionsFileContent)
+	writeHandlers(&buf, "handlers", "Instructions", unprefixed, handlers)
+	writeHandlers(&buf, "prefixedHandlers", "PrefixedInstructions", cbPrefixed, handlers)
 
-	goInstructionsFile, err := os.Create("instructions_map")
+	err = writeSource(filepath.Join(dir, output), &buf)
 	if err != nil {
 		panic(err)
 	}
-	defer goInstructionsFile.Close()
 
-	_, err = goInstructionsFile.WriteString(goInstructionsFileContent)
+	buf.Reset()
+
+	fmt.Fprintf(&buf, "%spackage %s\n\nimport \"testing\"\n\n", header, pkgName)
+
+	writeTests(&buf, "OpCode", false, unprefixed, handlers)
+	writeTests(&buf, "PrefixedOpCode", true, cbPrefixed, handlers)
+
+	err = writeSource(filepath.Join(dir, testOutput), &buf)
 	if err != nil {
 		panic(err)
 	}
@@ -1,5 +1,5 @@
 module github.com/adnsio/gbemu
 
-go 1.12
+go 1.16
 
 require github.com/veandco/go-sdl2 v0.3.1-0.20190807021614-07105104c379
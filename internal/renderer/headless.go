@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/signal"
+	"path/filepath"
+)
+
+// Headless runs without a window, as on servers. The frames are written to
+// Dir as png files when it's set, an interrupt quits.
+type Headless struct {
+	Dir string
+	// Every is the number of frames between the frames written
+	Every int
+	// Frame is the number of frames presented
+	Frame int
+	Title string
+
+	interrupts chan os.Signal
+	events     []Event
+}
+
+func NewHeadless(dir string, every int) *Headless {
+	if every < 1 {
+		every = 1
+	}
+
+	return &Headless{
+		Dir:   dir,
+		Every: every,
+	}
+}
+
+func (h *Headless) Init() error {
+	if h.Dir != "" {
+		if err := os.MkdirAll(h.Dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	h.interrupts = make(chan os.Signal, 1)
+	signal.Notify(h.interrupts, os.Interrupt)
+
+	return nil
+}
+
+func (h *Headless) Close() {
+	signal.Stop(h.interrupts)
+}
+
+// FramePath returns the path of the png written for a frame.
+func (h *Headless) FramePath(frame int) string {
+	return filepath.Join(h.Dir, fmt.Sprintf("frame%06d.png", frame))
+}
+
+func (h *Headless) PresentFrame(img *image.RGBA) error {
+	h.Frame++
+
+	if h.Dir == "" || h.Frame%h.Every != 0 {
+		return nil
+	}
+
+	file, err := os.Create(h.FramePath(h.Frame))
+	if err != nil {
+		return err
+	}
+
+	err = png.Encode(file, img)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (h *Headless) PollInput() []Event {
+	h.events = h.events[:0]
+
+	select {
+	case <-h.interrupts:
+		h.events = append(h.events, Event{Type: EventQuit})
+	default:
+	}
+
+	return h.events
+}
+
+func (h *Headless) QueueAudio(samples []int16) error {
+	return nil
+}
+
+func (h *Headless) SetTitle(title string) {
+	h.Title = title
+}
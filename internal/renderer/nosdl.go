@@ -0,0 +1,27 @@
+//go:build nosdl
+// +build nosdl
+
+package renderer
+
+import (
+	"errors"
+	"image"
+)
+
+// nosdl replaces the sdl backend in the builds with the nosdl tag, which
+// don't link sdl.
+type nosdl struct{}
+
+func NewSDL(debugWindows bool) Backend {
+	return nosdl{}
+}
+
+func (nosdl) Init() error {
+	return errors.New("renderer: sdl not available, built with the nosdl tag")
+}
+
+func (nosdl) Close()                             {}
+func (nosdl) PresentFrame(img *image.RGBA) error { return nil }
+func (nosdl) PollInput() []Event                 { return nil }
+func (nosdl) QueueAudio(samples []int16) error   { return nil }
+func (nosdl) SetTitle(title string)              {}
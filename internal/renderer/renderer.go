@@ -3,74 +3,126 @@ package renderer
 import (
 	"fmt"
 	"image"
+	"os"
 	"runtime"
 	"time"
 
 	"github.com/adnsio/gbemu/pkg/gameboy"
 	"github.com/adnsio/gbemu/pkg/gameboy/bits"
-	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
-	"github.com/veandco/go-sdl2/sdl"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
 )
 
 const (
-	//MainWindowWidth        = 160
-	//MainWindowHeight       = 144
-	MainWindowScale = 1
-	//TilesWindowWidth       = 8 * 16
-	//TilesWindowHeight      = 8 * 16
-	//TilesWindowScale       = 1
 	BackgroundWindowWidth  = 8 * 32
 	BackgroundWindowHeight = 8 * 32
-	BackgroundWindowScale  = 1
 )
 
+type EventType int
+
+const (
+	EventQuit EventType = iota
+	EventPress
+	EventRelease
+	EventRewindStart
+	EventRewindStop
+	EventSaveState
+	EventLoadState
+)
+
+// Event is an input of the front end, translated by the backend from its own
+// keys or commands.
+type Event struct {
+	Type EventType
+	// Buttons are the buttons pressed or released
+	Buttons joypad.Buttons
+	// Slot is the save state slot saved or loaded
+	Slot int
+}
+
+// Backend is the front end showing the game boy, as a window or nothing at
+// all. Its methods are called from the goroutine running the renderer.
+type Backend interface {
+	Init() error
+	Close()
+	// PresentFrame shows the last frame of the display
+	PresentFrame(img *image.RGBA) error
+	// PollInput returns the events received since the last call
+	PollInput() []Event
+	// QueueAudio plays the interleaved stereo samples after the queued ones
+	QueueAudio(samples []int16) error
+	SetTitle(title string)
+}
+
+// BackgroundPresenter is implemented by the backends showing the background
+// map in a debug window.
+type BackgroundPresenter interface {
+	PresentBackground(img *image.RGBA) error
+}
+
+// Messenger is implemented by the backends drawing on the standard output,
+// which show the messages of the renderer themselves.
+type Messenger interface {
+	Message(msg string)
+}
+
 type Config struct {
+	Backend      Backend
 	DebugWindows bool
 	GameBoy      *gameboy.GameBoy
+	// StatePath is the base path of the save state slots, <StatePath>.ss<slot>
+	StatePath string
+	// RunFrame runs a frame of the game boy, GameBoy.RunFrame by default
+	RunFrame func() error
+	// FrameRate is the number of frames run per second, 0 runs them as fast
+	// as possible
+	FrameRate int
+	// MaxFrames stops the renderer after the given number of frames, 0 runs
+	// until the backend quits
+	MaxFrames int
+	// StopOnError returns the errors of RunFrame from Run, for the backends
+	// without input, instead of pausing the game boy
+	StopOnError bool
 }
 
 type Renderer struct {
-	MainWindow            *sdl.Window
-	MainRenderer          *sdl.Renderer
-	MainTexture           *sdl.Texture
-	BackgroundWindow      *sdl.Window
-	BackgroundRenderer    *sdl.Renderer
-	BackgroundTexture     *sdl.Texture
+	Backend               Backend
 	BackgroundImage       *image.RGBA
 	IsDebugWindowsEnabled bool
 	GameBoy               *gameboy.GameBoy
+	StatePath             string
+	Rewinding             bool
+	RunFrame              func() error
+	FrameRate             int
+	MaxFrames             int
+	StopOnError           bool
+
+	title string
+	// halted stops the frames after an error, until a state is loaded or
+	// the game boy is rewound
+	halted bool
 }
 
 func NewRenderer(cfg Config) *Renderer {
 	rdr := &Renderer{
+		Backend:               cfg.Backend,
 		IsDebugWindowsEnabled: cfg.DebugWindows,
 		GameBoy:               cfg.GameBoy,
+		StatePath:             cfg.StatePath,
+		RunFrame:              cfg.RunFrame,
+		FrameRate:             cfg.FrameRate,
+		MaxFrames:             cfg.MaxFrames,
+		StopOnError:           cfg.StopOnError,
 		BackgroundImage:       image.NewRGBA(image.Rect(0, 0, BackgroundWindowWidth, BackgroundWindowHeight)),
 	}
 
-	return rdr
-}
-
-func (rdr *Renderer) CreateBackgroundWindow() {
-	var err error
-
-	rdr.BackgroundWindow, err = sdl.CreateWindow("gbemu - background", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED, int32(BackgroundWindowWidth*BackgroundWindowScale), int32(BackgroundWindowHeight*BackgroundWindowScale), sdl.WINDOW_SHOWN)
-	if err != nil {
-		panic(err)
+	if rdr.RunFrame == nil {
+		rdr.RunFrame = rdr.GameBoy.RunFrame
 	}
 
-	rdr.BackgroundRenderer, err = sdl.CreateRenderer(rdr.BackgroundWindow, -1, sdl.RENDERER_ACCELERATED)
-	if err != nil {
-		panic(err)
-	}
-
-	rdr.BackgroundTexture, err = rdr.BackgroundRenderer.CreateTexture(uint32(sdl.PIXELFORMAT_RGBA32), sdl.TEXTUREACCESS_STREAMING, int32(BackgroundWindowWidth), int32(BackgroundWindowHeight))
-	if err != nil {
-		panic(err)
-	}
+	return rdr
 }
 
-func (rdr *Renderer) UpdateBackgroundWindow() {
+func (rdr *Renderer) UpdateBackgroundImage() {
 	bgMapSelect := 0
 	bgTileSelect := 1
 
@@ -111,106 +163,167 @@ func (rdr *Renderer) UpdateBackgroundWindow() {
 			rdr.BackgroundImage.SetRGBA(x, y, rdr.GameBoy.Hardware.Display.ShadesOfGray[color])
 		}
 	}
+}
 
-	err := rdr.BackgroundTexture.Update(nil, rdr.BackgroundImage.Pix, rdr.BackgroundImage.Stride)
-	if err != nil {
-		panic(err)
-	}
-
-	err = rdr.BackgroundRenderer.Clear()
-	if err != nil {
-		panic(err)
-	}
-
-	err = rdr.BackgroundRenderer.Copy(rdr.BackgroundTexture, nil, nil)
-	if err != nil {
-		panic(err)
+// HandleEvent applies an input event, it returns false on EventQuit.
+func (rdr *Renderer) HandleEvent(e Event) bool {
+	switch e.Type {
+	case EventQuit:
+		return false
+	case EventPress:
+		rdr.GameBoy.Hardware.Joypad.Press(e.Buttons)
+	case EventRelease:
+		rdr.GameBoy.Hardware.Joypad.Release(e.Buttons)
+	case EventRewindStart:
+		rdr.Rewinding = true
+	case EventRewindStop:
+		rdr.Rewinding = false
+	case EventSaveState:
+		rdr.SaveState(e.Slot)
+	case EventLoadState:
+		rdr.LoadState(e.Slot)
 	}
 
-	rdr.BackgroundRenderer.Present()
+	return true
 }
 
-func (rdr *Renderer) CreateMainWindow() {
-	var err error
+func (rdr *Renderer) StateSlotPath(slot int) string {
+	return fmt.Sprintf("%s.ss%d", rdr.StatePath, slot)
+}
 
-	rdr.MainWindow, err = sdl.CreateWindow("gbemu", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED, int32(display.Width*MainWindowScale), int32(display.Height*MainWindowScale), sdl.WINDOW_SHOWN)
-	if err != nil {
-		panic(err)
+func (rdr *Renderer) SaveState(slot int) {
+	file, err := os.Create(rdr.StateSlotPath(slot))
+	if err == nil {
+		err = rdr.GameBoy.SaveState(file)
+		file.Close()
 	}
 
-	rdr.MainRenderer, err = sdl.CreateRenderer(rdr.MainWindow, -1, sdl.RENDERER_ACCELERATED)
 	if err != nil {
-		panic(err)
+		rdr.Messagef("renderer: saving state %d: %v", slot, err)
+		return
 	}
 
-	rdr.MainTexture, err = rdr.MainRenderer.CreateTexture(uint32(sdl.PIXELFORMAT_RGBA32), sdl.TEXTUREACCESS_STREAMING, int32(display.Width), int32(display.Height))
-	if err != nil {
-		panic(err)
-	}
+	rdr.Messagef("renderer: saved state %d", slot)
 }
 
-func (rdr *Renderer) UpdateMainWindow() {
-	err := rdr.MainTexture.Update(nil, rdr.GameBoy.Hardware.Display.Image.Pix, rdr.GameBoy.Hardware.Display.Image.Stride)
-	if err != nil {
-		panic(err)
+func (rdr *Renderer) LoadState(slot int) {
+	file, err := os.Open(rdr.StateSlotPath(slot))
+	if err == nil {
+		err = rdr.GameBoy.LoadState(file)
+		file.Close()
 	}
 
-	err = rdr.MainRenderer.Clear()
 	if err != nil {
-		panic(err)
+		rdr.Messagef("renderer: loading state %d: %v", slot, err)
+		return
 	}
 
-	err = rdr.MainRenderer.Copy(rdr.MainTexture, nil, nil)
-	if err != nil {
-		panic(err)
+	rdr.halted = false
+	rdr.Messagef("renderer: loaded state %d", slot)
+}
+
+// Messagef shows a message on the backend if it's a Messenger, on the
+// standard output otherwise.
+func (rdr *Renderer) Messagef(format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+
+	if messenger, ok := rdr.Backend.(Messenger); ok {
+		messenger.Message(msg)
+		return
 	}
 
-	rdr.MainRenderer.Present()
+	fmt.Println(msg)
 }
 
-func (rdr *Renderer) Run() {
+// Run runs the frames and shows them on the backend until it quits, it
+// returns an error if the backend fails, or the frame with StopOnError.
+func (rdr *Renderer) Run() error {
+	// sdl must be called from the same thread
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	err := sdl.Init(sdl.INIT_VIDEO | sdl.INIT_AUDIO)
-	if err != nil {
-		panic(err)
+	if err := rdr.Backend.Init(); err != nil {
+		return err
 	}
-	defer sdl.Quit()
+	defer rdr.Backend.Close()
 
-	rdr.CreateMainWindow()
-	defer rdr.MainWindow.Destroy()
-	defer rdr.MainRenderer.Destroy()
-	defer rdr.MainTexture.Destroy()
+	var ticker *time.Ticker
+	if rdr.FrameRate > 0 {
+		ticker = time.NewTicker(time.Second / time.Duration(rdr.FrameRate))
+		defer ticker.Stop()
+	}
 
-	rdr.CreateBackgroundWindow()
-	defer rdr.BackgroundWindow.Destroy()
-	defer rdr.BackgroundRenderer.Destroy()
-	defer rdr.BackgroundTexture.Destroy()
+	for frames := 0; rdr.MaxFrames == 0 || frames < rdr.MaxFrames; frames++ {
+		if ticker != nil {
+			<-ticker.C
+		}
 
-	ticker := time.NewTicker(time.Second / 60)
-	running := true
+		for _, e := range rdr.Backend.PollInput() {
+			if !rdr.HandleEvent(e) {
+				return nil
+			}
+		}
 
-	for range ticker.C {
-		if !running {
-			break
+		if err := rdr.runFrame(); err != nil {
+			return err
 		}
 
-		for event := sdl.PollEvent(); event != nil; event = sdl.PollEvent() {
-			switch event.(type) {
-			case *sdl.QuitEvent:
-				running = false
-				break
-			}
+		if err := rdr.present(); err != nil {
+			return err
 		}
+	}
 
-		rdr.GameBoy.RunFrame()
+	return nil
+}
 
-		rdr.UpdateMainWindow()
-		rdr.UpdateBackgroundWindow()
+// runFrame runs a frame, or rewinds one, it returns the frame error with
+// StopOnError.
+func (rdr *Renderer) runFrame() error {
+	if rdr.Rewinding {
+		// errors just mean there is nothing left to rewind
+		if rdr.GameBoy.Rewind(1) == nil {
+			rdr.halted = false
+		}
 
-		if rdr.GameBoy.Hardware.Cartrdige.Title != "" {
-			rdr.MainWindow.SetTitle(fmt.Sprintf("gbemu - %s", rdr.GameBoy.Hardware.Cartrdige.Title))
+		return nil
+	}
+
+	if rdr.halted {
+		return nil
+	}
+
+	if err := rdr.RunFrame(); err != nil {
+		if rdr.StopOnError {
+			return err
+		}
+
+		// the last frame stays on screen, states can still be loaded
+		rdr.Messagef("%v", err)
+		rdr.halted = true
+	}
+
+	return nil
+}
+
+func (rdr *Renderer) present() error {
+	if err := rdr.Backend.PresentFrame(rdr.GameBoy.Hardware.Display.Image); err != nil {
+		return err
+	}
+
+	if presenter, ok := rdr.Backend.(BackgroundPresenter); ok && rdr.IsDebugWindowsEnabled {
+		rdr.UpdateBackgroundImage()
+
+		if err := presenter.PresentBackground(rdr.BackgroundImage); err != nil {
+			return err
 		}
 	}
+
+	// todo queue the audio samples once the apu produces them
+
+	if title := rdr.GameBoy.Hardware.Cartrdige.Title; title != "" && title != rdr.title {
+		rdr.Backend.SetTitle(fmt.Sprintf("gbemu - %s", title))
+		rdr.title = title
+	}
+
+	return nil
 }
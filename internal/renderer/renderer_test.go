@@ -0,0 +1,132 @@
+package renderer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+)
+
+func TestRenderer_Headless(t *testing.T) {
+	// a rom looping on JR -2
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0x18, 0xfe})
+	copy(rom[0x0134:], "LOOP")
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "gbemu-headless")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	backend := NewHeadless(dir, 2)
+	rdr := NewRenderer(Config{
+		Backend:   backend,
+		GameBoy:   gb,
+		MaxFrames: 5,
+	})
+
+	if err := rdr.Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if gb.Frame != 5 || backend.Frame != 5 {
+		t.Errorf("frames error: want 5, got %d run, %d presented", gb.Frame, backend.Frame)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 2 || files[0].Name() != "frame000002.png" || files[1].Name() != "frame000004.png" {
+		t.Errorf("files error: got %d files", len(files))
+	}
+
+	if backend.Title != "gbemu - LOOP" {
+		t.Errorf("title error: got %q", backend.Title)
+	}
+}
+
+func TestRenderer_StopOnError(t *testing.T) {
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: make([]uint8, 0x8000)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	frames := 0
+	wantErr := errors.New("frame error")
+
+	rdr := NewRenderer(Config{
+		Backend: NewHeadless("", 1),
+		GameBoy: gb,
+		RunFrame: func() error {
+			frames++
+			return wantErr
+		},
+		StopOnError: true,
+	})
+
+	if err := rdr.Run(); err != wantErr {
+		t.Errorf("run error: want %v, got %v", wantErr, err)
+	}
+
+	if frames != 1 {
+		t.Errorf("frames error: want 1, got %d", frames)
+	}
+}
+
+func TestRenderer_LoadStateAfterError(t *testing.T) {
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: make([]uint8, 0x8000)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "gbemu-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	frames := 0
+	fail := true
+
+	rdr := NewRenderer(Config{
+		Backend:   NewHeadless("", 1),
+		GameBoy:   gb,
+		StatePath: filepath.Join(dir, "rom"),
+		RunFrame: func() error {
+			frames++
+			if fail {
+				return errors.New("frame error")
+			}
+
+			return nil
+		},
+	})
+
+	rdr.SaveState(1)
+
+	rdr.runFrame()
+	fail = false
+	rdr.runFrame()
+
+	if frames != 1 {
+		t.Errorf("halted error: want 1 frame, got %d", frames)
+	}
+
+	rdr.LoadState(1)
+	rdr.runFrame()
+
+	if frames != 2 {
+		t.Errorf("load state error: want 2 frames, got %d", frames)
+	}
+}
@@ -0,0 +1,248 @@
+//go:build !nosdl
+// +build !nosdl
+
+package renderer
+
+import (
+	"encoding/binary"
+	"image"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	MainWindowScale       = 1
+	BackgroundWindowScale = 1
+
+	AudioFrequency = 48000
+	AudioChannels  = 2
+)
+
+var KeyMap = map[sdl.Keycode]joypad.Buttons{
+	sdl.K_RIGHT:  joypad.ButtonRight,
+	sdl.K_LEFT:   joypad.ButtonLeft,
+	sdl.K_UP:     joypad.ButtonUp,
+	sdl.K_DOWN:   joypad.ButtonDown,
+	sdl.K_x:      joypad.ButtonA,
+	sdl.K_z:      joypad.ButtonB,
+	sdl.K_RSHIFT: joypad.ButtonSelect,
+	sdl.K_RETURN: joypad.ButtonStart,
+}
+
+const RewindKey = sdl.K_BACKSPACE
+
+var StateSlotKeys = map[sdl.Keycode]int{
+	sdl.K_F1: 1,
+	sdl.K_F2: 2,
+	sdl.K_F3: 3,
+	sdl.K_F4: 4,
+	sdl.K_F5: 5,
+	sdl.K_F6: 6,
+	sdl.K_F7: 7,
+	sdl.K_F8: 8,
+	sdl.K_F9: 9,
+}
+
+// SDL shows the game boy in a window, and the background map in a second one
+// with the debug windows.
+type SDL struct {
+	MainWindow         *sdl.Window
+	MainRenderer       *sdl.Renderer
+	MainTexture        *sdl.Texture
+	BackgroundWindow   *sdl.Window
+	BackgroundRenderer *sdl.Renderer
+	BackgroundTexture  *sdl.Texture
+	AudioDevice        sdl.AudioDeviceID
+	DebugWindows       bool
+
+	events     []Event
+	audioBytes []byte
+}
+
+func NewSDL(debugWindows bool) Backend {
+	return &SDL{
+		DebugWindows: debugWindows,
+	}
+}
+
+func (s *SDL) Init() error {
+	err := sdl.Init(sdl.INIT_VIDEO | sdl.INIT_AUDIO)
+	if err != nil {
+		return err
+	}
+
+	s.MainWindow, s.MainRenderer, s.MainTexture, err = createWindow("gbemu", display.Width, display.Height, MainWindowScale)
+	if err != nil {
+		return err
+	}
+
+	if s.DebugWindows {
+		s.BackgroundWindow, s.BackgroundRenderer, s.BackgroundTexture, err = createWindow("gbemu - background", BackgroundWindowWidth, BackgroundWindowHeight, BackgroundWindowScale)
+		if err != nil {
+			return err
+		}
+	}
+
+	spec := sdl.AudioSpec{
+		Freq:     AudioFrequency,
+		Format:   sdl.AUDIO_S16LSB,
+		Channels: AudioChannels,
+		Samples:  1024,
+	}
+
+	// without an audio device the game runs silent
+	s.AudioDevice, err = sdl.OpenAudioDevice("", false, &spec, nil, 0)
+	if err == nil {
+		sdl.PauseAudioDevice(s.AudioDevice, false)
+	}
+
+	return nil
+}
+
+func createWindow(title string, width, height, scale int) (*sdl.Window, *sdl.Renderer, *sdl.Texture, error) {
+	window, err := sdl.CreateWindow(title, sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED, int32(width*scale), int32(height*scale), sdl.WINDOW_SHOWN)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	renderer, err := sdl.CreateRenderer(window, -1, sdl.RENDERER_ACCELERATED)
+	if err != nil {
+		window.Destroy()
+		return nil, nil, nil, err
+	}
+
+	texture, err := renderer.CreateTexture(uint32(sdl.PIXELFORMAT_RGBA32), sdl.TEXTUREACCESS_STREAMING, int32(width), int32(height))
+	if err != nil {
+		renderer.Destroy()
+		window.Destroy()
+		return nil, nil, nil, err
+	}
+
+	return window, renderer, texture, nil
+}
+
+func (s *SDL) Close() {
+	if s.AudioDevice != 0 {
+		sdl.CloseAudioDevice(s.AudioDevice)
+	}
+
+	if s.BackgroundWindow != nil {
+		s.BackgroundTexture.Destroy()
+		s.BackgroundRenderer.Destroy()
+		s.BackgroundWindow.Destroy()
+	}
+
+	if s.MainWindow != nil {
+		s.MainTexture.Destroy()
+		s.MainRenderer.Destroy()
+		s.MainWindow.Destroy()
+	}
+
+	sdl.Quit()
+}
+
+func (s *SDL) PresentFrame(img *image.RGBA) error {
+	return present(s.MainRenderer, s.MainTexture, img)
+}
+
+func (s *SDL) PresentBackground(img *image.RGBA) error {
+	if s.BackgroundWindow == nil {
+		return nil
+	}
+
+	return present(s.BackgroundRenderer, s.BackgroundTexture, img)
+}
+
+func present(renderer *sdl.Renderer, texture *sdl.Texture, img *image.RGBA) error {
+	err := texture.Update(nil, img.Pix, img.Stride)
+	if err != nil {
+		return err
+	}
+
+	err = renderer.Clear()
+	if err != nil {
+		return err
+	}
+
+	err = renderer.Copy(texture, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	renderer.Present()
+
+	return nil
+}
+
+func (s *SDL) PollInput() []Event {
+	s.events = s.events[:0]
+
+	for event := sdl.PollEvent(); event != nil; event = sdl.PollEvent() {
+		switch e := event.(type) {
+		case *sdl.QuitEvent:
+			s.events = append(s.events, Event{Type: EventQuit})
+		case *sdl.KeyboardEvent:
+			s.handleKey(e)
+		}
+	}
+
+	return s.events
+}
+
+func (s *SDL) handleKey(e *sdl.KeyboardEvent) {
+	down := e.Type == sdl.KEYDOWN
+
+	if e.Keysym.Sym == RewindKey {
+		if down {
+			s.events = append(s.events, Event{Type: EventRewindStart})
+		} else {
+			s.events = append(s.events, Event{Type: EventRewindStop})
+		}
+
+		return
+	}
+
+	if slot, ok := StateSlotKeys[e.Keysym.Sym]; ok {
+		if down && e.Repeat == 0 {
+			if e.Keysym.Mod&sdl.KMOD_SHIFT != 0 {
+				s.events = append(s.events, Event{Type: EventLoadState, Slot: slot})
+			} else {
+				s.events = append(s.events, Event{Type: EventSaveState, Slot: slot})
+			}
+		}
+
+		return
+	}
+
+	button, ok := KeyMap[e.Keysym.Sym]
+	if !ok {
+		return
+	}
+
+	if down {
+		s.events = append(s.events, Event{Type: EventPress, Buttons: button})
+	} else {
+		s.events = append(s.events, Event{Type: EventRelease, Buttons: button})
+	}
+}
+
+func (s *SDL) QueueAudio(samples []int16) error {
+	if s.AudioDevice == 0 {
+		return nil
+	}
+
+	s.audioBytes = s.audioBytes[:0]
+
+	for _, sample := range samples {
+		s.audioBytes = append(s.audioBytes, 0, 0)
+		binary.LittleEndian.PutUint16(s.audioBytes[len(s.audioBytes)-2:], uint16(sample))
+	}
+
+	return sdl.QueueAudio(s.AudioDevice, s.audioBytes)
+}
+
+func (s *SDL) SetTitle(title string) {
+	s.MainWindow.SetTitle(title)
+}
@@ -0,0 +1,400 @@
+package renderer
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+)
+
+// DefaultHoldFrames is the number of frames a button stays pressed after its
+// key, terminals send the key repeats but not the releases.
+const DefaultHoldFrames = 10
+
+// TerminalKeyMap are the joypad keys in the terminal, the arrows are escape
+// sequences in normal and application mode. The digits 1 to 9 save the state
+// slots and alt with the digit loads them, q and ctrl-c quit.
+var TerminalKeyMap = map[string]joypad.Buttons{
+	"\x1b[C": joypad.ButtonRight,
+	"\x1b[D": joypad.ButtonLeft,
+	"\x1b[A": joypad.ButtonUp,
+	"\x1b[B": joypad.ButtonDown,
+	"\x1bOC": joypad.ButtonRight,
+	"\x1bOD": joypad.ButtonLeft,
+	"\x1bOA": joypad.ButtonUp,
+	"\x1bOB": joypad.ButtonDown,
+	"x":      joypad.ButtonA,
+	"z":      joypad.ButtonB,
+	" ":      joypad.ButtonSelect,
+	"\r":     joypad.ButtonStart,
+}
+
+const (
+	// TerminalRewindKey is backspace
+	TerminalRewindKey = "\x7f"
+	// TerminalRedrawKey is ctrl-l, it redraws the whole screen
+	TerminalRedrawKey = "\x0c"
+)
+
+// upperHalfBlock is drawn with the top pixel as foreground and the bottom one
+// as background
+const upperHalfBlock = "▀"
+
+// cell is a character of the screen, two pixels high.
+type cell struct {
+	top, bottom uint32
+}
+
+// Terminal draws the game boy in a text console with ANSI escape codes, two
+// pixels a character. Only the cells changed since the previous frame are
+// drawn.
+type Terminal struct {
+	// TrueColor draws with 24-bit colors instead of the 256 colors palette
+	TrueColor  bool
+	HoldFrames int
+
+	in      io.Reader
+	out     io.Writer
+	restore func() error
+	input   chan []byte
+
+	cells  []cell
+	width  int
+	redraw bool
+	buf    bytes.Buffer
+
+	// status is the last message, shown under the screen
+	status        string
+	statusChanged bool
+
+	held      [8]int
+	rewinding int
+	events    []Event
+}
+
+// NewTerminal returns a terminal backend reading the keys from in, put in raw
+// mode if it's a terminal, and drawing to out. The colors are 24-bit if
+// COLORTERM says so.
+func NewTerminal(in io.Reader, out io.Writer) *Terminal {
+	colorTerm := os.Getenv("COLORTERM")
+
+	return &Terminal{
+		TrueColor:  colorTerm == "truecolor" || colorTerm == "24bit",
+		HoldFrames: DefaultHoldFrames,
+		in:         in,
+		out:        out,
+		redraw:     true,
+	}
+}
+
+func (t *Terminal) Init() error {
+	if f, ok := t.in.(*os.File); ok && isTerminal(f.Fd()) {
+		restore, err := makeRaw(f.Fd())
+		if err != nil {
+			return err
+		}
+
+		t.restore = restore
+	}
+
+	if t.in != nil {
+		t.input = make(chan []byte, 16)
+		go t.readInput(t.input)
+	}
+
+	// alternate screen, cursor hidden
+	_, err := io.WriteString(t.out, "\x1b[?1049h\x1b[?25l\x1b[2J")
+	return err
+}
+
+func (t *Terminal) readInput(input chan<- []byte) {
+	defer close(input)
+
+	for {
+		buf := make([]byte, 64)
+
+		n, err := t.in.Read(buf)
+		if n > 0 {
+			input <- buf[:n]
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (t *Terminal) Close() {
+	io.WriteString(t.out, "\x1b[0m\x1b[?25h\x1b[?1049l")
+
+	if t.restore != nil {
+		t.restore()
+	}
+}
+
+func (t *Terminal) PresentFrame(img *image.RGBA) error {
+	bounds := img.Bounds()
+	width, rows := bounds.Dx(), (bounds.Dy()+1)/2
+
+	if t.width != width || len(t.cells) != width*rows {
+		t.width = width
+		t.cells = make([]cell, width*rows)
+		t.redraw = true
+	}
+
+	t.buf.Reset()
+
+	// the cursor position and colors after the last cell drawn
+	cursor := -1
+	var fg, bg uint32
+	colorsSet := false
+
+	for i := range t.cells {
+		x, y := i%width, i/width*2
+
+		c := cell{top: t.pixel(img, bounds.Min.X+x, bounds.Min.Y+y)}
+		if y+1 < bounds.Dy() {
+			c.bottom = t.pixel(img, bounds.Min.X+x, bounds.Min.Y+y+1)
+		}
+
+		if !t.redraw && t.cells[i] == c {
+			continue
+		}
+
+		t.cells[i] = c
+
+		if cursor != i {
+			fmt.Fprintf(&t.buf, "\x1b[%d;%dH", i/width+1, x+1)
+		}
+
+		if !colorsSet || c.top != fg {
+			t.writeColor(38, c.top)
+		}
+
+		if !colorsSet || c.bottom != bg {
+			t.writeColor(48, c.bottom)
+		}
+
+		fg, bg, colorsSet = c.top, c.bottom, true
+
+		t.buf.WriteString(upperHalfBlock)
+		cursor = i + 1
+		if cursor%width == 0 {
+			// the cursor stays on the last column
+			cursor = -1
+		}
+	}
+
+	if t.statusChanged || t.redraw {
+		fmt.Fprintf(&t.buf, "\x1b[0m\x1b[%d;1H\x1b[2K%s", rows+1, t.status)
+		t.statusChanged = false
+	}
+
+	t.redraw = false
+
+	if t.buf.Len() == 0 {
+		return nil
+	}
+
+	t.buf.WriteString("\x1b[0m")
+
+	_, err := t.out.Write(t.buf.Bytes())
+	return err
+}
+
+// pixel returns the color of a pixel as 0xrrggbb, or as the 256 colors index.
+func (t *Terminal) pixel(img *image.RGBA, x, y int) uint32 {
+	i := img.PixOffset(x, y)
+	r, g, b := img.Pix[i], img.Pix[i+1], img.Pix[i+2]
+
+	if t.TrueColor {
+		return uint32(r)<<16 | uint32(g)<<8 | uint32(b)
+	}
+
+	return uint32(ansi256(r, g, b))
+}
+
+func (t *Terminal) writeColor(sgr int, c uint32) {
+	if t.TrueColor {
+		fmt.Fprintf(&t.buf, "\x1b[%d;2;%d;%d;%dm", sgr, c>>16, c>>8&0xff, c&0xff)
+	} else {
+		fmt.Fprintf(&t.buf, "\x1b[%d;5;%dm", sgr, c)
+	}
+}
+
+// cubeLevels are the levels of the 6x6x6 color cube of the 256 colors
+// palette, from index 16.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// ansi256 returns the closest color of the 256 colors palette, from the color
+// cube or the gray ramp.
+func ansi256(r, g, b uint8) int {
+	cube := func(v uint8) int {
+		switch {
+		case v < 48:
+			return 0
+		case v < 115:
+			return 1
+		default:
+			return (int(v) - 35) / 40
+		}
+	}
+
+	cr, cg, cb := cube(r), cube(g), cube(b)
+	cubeIndex := 16 + 36*cr + 6*cg + cb
+	cubeDist := colorDist(r, g, b, cubeLevels[cr], cubeLevels[cg], cubeLevels[cb])
+
+	// the gray ramp goes from 8 to 238 by 10
+	gray := (int(r) + int(g) + int(b)) / 3
+	grayStep := (gray - 3) / 10
+	if gray < 3 {
+		grayStep = 0
+	} else if grayStep > 23 {
+		grayStep = 23
+	}
+
+	grayLevel := 8 + grayStep*10
+	if colorDist(r, g, b, grayLevel, grayLevel, grayLevel) < cubeDist {
+		return 232 + grayStep
+	}
+
+	return cubeIndex
+}
+
+func colorDist(r, g, b uint8, r2, g2, b2 int) int {
+	dr, dg, db := int(r)-r2, int(g)-g2, int(b)-b2
+	return dr*dr + dg*dg + db*db
+}
+
+func (t *Terminal) PollInput() []Event {
+	t.events = t.events[:0]
+
+	// the buttons are released once their keys stop repeating
+	for i := range t.held {
+		if t.held[i] > 0 {
+			t.held[i]--
+
+			if t.held[i] == 0 {
+				t.events = append(t.events, Event{Type: EventRelease, Buttons: 1 << uint(i)})
+			}
+		}
+	}
+
+	if t.rewinding > 0 {
+		t.rewinding--
+
+		if t.rewinding == 0 {
+			t.events = append(t.events, Event{Type: EventRewindStop})
+		}
+	}
+
+	for {
+		select {
+		case data, ok := <-t.input:
+			if !ok {
+				t.input = nil
+				return t.events
+			}
+
+			for _, key := range splitKeys(data) {
+				t.handleKey(key)
+			}
+		default:
+			return t.events
+		}
+	}
+}
+
+// splitKeys splits the keys read at once, the escape sequences of the special
+// keys are kept whole.
+func splitKeys(data []byte) []string {
+	var keys []string
+
+	for i := 0; i < len(data); {
+		end := i + 1
+
+		if data[i] == 0x1b && i+1 < len(data) {
+			end = i + 2
+
+			if data[i+1] == '[' || data[i+1] == 'O' {
+				// control sequence, up to the final byte
+				for end < len(data) && (data[end] < 0x40 || data[end] > 0x7e) {
+					end++
+				}
+
+				if end < len(data) {
+					end++
+				}
+			}
+		}
+
+		keys = append(keys, string(data[i:end]))
+		i = end
+	}
+
+	return keys
+}
+
+func (t *Terminal) handleKey(key string) {
+	switch {
+	case key == "q" || key == "\x03":
+		t.events = append(t.events, Event{Type: EventQuit})
+	case key == TerminalRedrawKey:
+		t.redraw = true
+	case key == TerminalRewindKey:
+		if t.rewinding == 0 {
+			t.events = append(t.events, Event{Type: EventRewindStart})
+		}
+
+		t.rewinding = t.HoldFrames
+	case len(key) == 1 && key[0] >= '1' && key[0] <= '9':
+		t.events = append(t.events, Event{Type: EventSaveState, Slot: int(key[0] - '0')})
+	case len(key) == 2 && key[0] == 0x1b && key[1] >= '1' && key[1] <= '9':
+		t.events = append(t.events, Event{Type: EventLoadState, Slot: int(key[1] - '0')})
+	default:
+		button, ok := TerminalKeyMap[key]
+		if !ok {
+			return
+		}
+
+		for i := range t.held {
+			if button&(1<<uint(i)) == 0 {
+				continue
+			}
+
+			if t.held[i] == 0 {
+				t.events = append(t.events, Event{Type: EventPress, Buttons: 1 << uint(i)})
+			}
+
+			t.held[i] = t.HoldFrames
+		}
+	}
+}
+
+// Message shows a message under the screen, with the next frame.
+func (t *Terminal) Message(msg string) {
+	t.status = strings.Map(printable, msg)
+	t.statusChanged = true
+}
+
+func (t *Terminal) QueueAudio(samples []int16) error {
+	return nil
+}
+
+func (t *Terminal) SetTitle(title string) {
+	io.WriteString(t.out, "\x1b]0;"+strings.Map(printable, title)+"\x07")
+}
+
+// printable drops the control characters, which could end the title escape
+// sequence.
+func printable(r rune) rune {
+	if r < 0x20 || r == 0x7f {
+		return -1
+	}
+
+	return r
+}
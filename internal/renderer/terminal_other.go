@@ -0,0 +1,16 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package renderer
+
+import "errors"
+
+// the terminal input is line buffered where raw mode isn't implemented
+
+func isTerminal(fd uintptr) bool {
+	return false
+}
+
+func makeRaw(fd uintptr) (func() error, error) {
+	return nil, errors.New("renderer: terminal raw mode not implemented")
+}
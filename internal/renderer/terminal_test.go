@@ -0,0 +1,132 @@
+package renderer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+)
+
+func TestTerminal_PresentFrame(t *testing.T) {
+	var out bytes.Buffer
+
+	term := NewTerminal(nil, &out)
+	term.TrueColor = true
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	img.SetRGBA(1, 3, color.RGBA{0x12, 0x34, 0x56, 0xff})
+
+	if err := term.PresentFrame(img); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := strings.Count(out.String(), upperHalfBlock); n != 8 {
+		t.Errorf("first frame error: want 8 cells, got %d", n)
+	}
+
+	out.Reset()
+	if err := term.PresentFrame(img); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("unchanged frame error: got %q", out.String())
+	}
+
+	img.SetRGBA(2, 2, color.RGBA{0xff, 0xff, 0xff, 0xff})
+	if err := term.PresentFrame(img); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "\x1b[2;3H\x1b[38;2;255;255;255m\x1b[48;2;0;0;0m" + upperHalfBlock + "\x1b[0m"
+	if out.String() != want {
+		t.Errorf("changed cell error: want %q, got %q", want, out.String())
+	}
+}
+
+func TestTerminal_PollInput(t *testing.T) {
+	term := NewTerminal(nil, &bytes.Buffer{})
+	term.HoldFrames = 2
+	term.input = make(chan []byte, 2)
+
+	term.input <- []byte("\x1b[Ax1\x1b2\x1b[15~")
+
+	want := []Event{
+		{Type: EventPress, Buttons: joypad.ButtonUp},
+		{Type: EventPress, Buttons: joypad.ButtonA},
+		{Type: EventSaveState, Slot: 1},
+		{Type: EventLoadState, Slot: 2},
+	}
+
+	if got := term.PollInput(); !reflect.DeepEqual(got, want) {
+		t.Errorf("keys error: want %v, got %v", want, got)
+	}
+
+	// the repeated key stays pressed
+	term.input <- []byte("x")
+	if got := term.PollInput(); len(got) != 0 {
+		t.Errorf("repeat error: got %v", got)
+	}
+
+	want = []Event{{Type: EventRelease, Buttons: joypad.ButtonUp}}
+	if got := term.PollInput(); !reflect.DeepEqual(got, want) {
+		t.Errorf("release error: want %v, got %v", want, got)
+	}
+
+	want = []Event{{Type: EventRelease, Buttons: joypad.ButtonA}, {Type: EventQuit}}
+	term.input <- []byte("q")
+	if got := term.PollInput(); !reflect.DeepEqual(got, want) {
+		t.Errorf("quit error: want %v, got %v", want, got)
+	}
+}
+
+func TestAnsi256(t *testing.T) {
+	tests := []struct {
+		r, g, b uint8
+		want    int
+	}{
+		{0x00, 0x00, 0x00, 16},
+		{0xff, 0xff, 0xff, 231},
+		{0xff, 0x00, 0x00, 196},
+		{0x80, 0x80, 0x80, 244},
+		{0x30, 0x30, 0x30, 236},
+	}
+
+	for _, test := range tests {
+		if got := ansi256(test.r, test.g, test.b); got != test.want {
+			t.Errorf("%02x%02x%02x error: want %d, got %d", test.r, test.g, test.b, test.want, got)
+		}
+	}
+}
+
+func TestTerminal_Message(t *testing.T) {
+	var out bytes.Buffer
+
+	term := NewTerminal(nil, &out)
+	img := image.NewRGBA(image.Rect(0, 0, 2, 4))
+
+	if err := term.PresentFrame(img); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	rdr := NewRenderer(Config{Backend: term, RunFrame: func() error { return nil }})
+	rdr.Messagef("renderer: saved state %d\n", 1)
+
+	if out.Len() != 0 {
+		t.Errorf("message error: written before the frame %q", out.String())
+	}
+
+	if err := term.PresentFrame(img); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "\x1b[0m\x1b[3;1H\x1b[2Krenderer: saved state 1\x1b[0m"
+	if out.String() != want {
+		t.Errorf("message error: want %q, got %q", want, out.String())
+	}
+}
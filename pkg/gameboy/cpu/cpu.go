@@ -3,7 +3,10 @@ package cpu
 import (
 	"errors"
 	"fmt"
+
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
 )
 
 type CPU struct {
@@ -19,7 +22,20 @@ type CPU struct {
 	PC uint16
 
 	IsNextInstructionPrefixed bool
-	Hardware                  *hardware.Hardware
+	// Locked is set by the unused opcodes, the cpu stops executing
+	// instructions as the real hardware does, only time goes on
+	Locked   bool
+	Hardware *hardware.Hardware
+	Log      logger.Logger
+	// Tick, if set, advances the rest of the system by the given cycles. It's
+	// called with an M-cycle at every memory access, before the access, and
+	// with the cycles left at the end of the instruction.
+	Tick func(cycles int)
+
+	// ticked is the number of cycles ticked in the current instruction
+	ticked int
+	// err is set by the handlers failing to execute an instruction
+	err error
 }
 
 func NewCPU(hwe *hardware.Hardware) *CPU {
@@ -31,6 +47,7 @@ func NewCPU(hwe *hardware.Hardware) *CPU {
 			Carry:     false,
 		},
 		Hardware: hwe,
+		Log:      logger.Discard,
 	}
 
 	return cpu
@@ -48,90 +65,105 @@ func NewCPU(hwe *hardware.Hardware) *CPU {
 	}
 }*/
 
-func (c *CPU) ExecuteNextInstruction() int {
-	opCode := c.Hardware.Read(c.PC)
+// ExecuteNextInstruction executes the next instruction and returns the cycles
+// it took, all of them already ticked. An instruction not implemented returns
+// an error, leaving PC on it.
+func (c *CPU) ExecuteNextInstruction() (int, error) {
+	c.ticked = 0
 
-	var inst *Instruction
-	if c.IsNextInstructionPrefixed {
+	if c.Locked {
+		c.tick()
+		return c.ticked, nil
+	}
+
+	var cycles int
+
+	if !c.IsNextInstructionPrefixed {
+		c.Hardware.InstructionPC = c.PC
+		cycles = handlers[c.fetch(c.PC)](c)
+	}
+
+	// the prefix and the prefixed instruction are executed as one, the
+	// prefixed cycles include the prefix fetch
+	if c.IsNextInstructionPrefixed && c.err == nil {
 		c.IsNextInstructionPrefixed = false
-		inst = c.GetPrefixedInstruction(opCode)
-	} else {
-		inst = c.GetInstruction(opCode)
+		cycles = prefixedHandlers[c.fetch(c.PC)](c)
+	}
+
+	if c.err != nil {
+		err := c.err
+		c.err = nil
+
+		return c.ticked, err
 	}
 
-	return c.ExecuteInstruction(inst)
+	if cycles <= c.ticked {
+		return c.ticked, nil
+	}
+
+	if c.Tick != nil {
+		c.Tick(cycles - c.ticked)
+	}
+
+	return cycles, nil
 }
 
-func (c *CPU) ExecuteInstruction(inst *Instruction) int {
-	//fmt.Printf("cpu: executing %#02x \"%s\", pc %#04x\n", inst.OpCode, inst.Description, c.PC)
-
-	switch inst.Name {
-	case "PREFIX":
-		return c.PrefixInst(inst)
-	case "NOP":
-		return c.NopInst(inst)
-	case "LD":
-		return c.LdInst(inst)
-	case "XOR":
-		return c.XorInst(inst)
-	case "BIT":
-		return c.BitInst(inst)
-	case "JR":
-		return c.JrInst(inst)
-	case "INC":
-		return c.IncInst(inst)
-	case "CALL":
-		return c.CallInst(inst)
-	case "PUSH":
-		return c.PushInst(inst)
-	case "RL":
-		return c.RlInst(inst)
-	case "RLA":
-		return c.RlaInst(inst)
-	case "POP":
-		return c.PopInst(inst)
-	case "DEC":
-		return c.DecInst(inst)
-	case "RET":
-		return c.RetInst(inst)
-	case "CP":
-		return c.CpInst(inst)
-	case "SUB":
-		return c.SubInst(inst)
-	case "ADD":
-		return c.AddInst(inst)
-	case "JP":
-		return c.JpInst(inst)
-	case "DI":
-		return c.DiInst(inst)
-	case "OR":
-		return c.OrInst(inst)
-	case "AND":
-		return c.AndInst(inst)
-	case "CPL":
-		return c.CplInst(inst)
-	case "EI":
-		return c.EiInst(inst)
-	case "SWAP":
-		return c.SwapInst(inst)
-	//case "RST": // todo
-	//	return c.RstInst(inst)
-	case "SRL":
-		return c.SrlInst(inst)
-	case "RR":
-		return c.RrInst(inst)
-	case "RRA":
-		return c.RraInst(inst)
-	//case "ADC": // todo
-	//	return c.AdcInst(inst)
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid instruction %#02x \"%s\"", inst.OpCode, inst.Description)))
+// fail stops the execution of the current instruction with an error.
+func (c *CPU) fail(format string, a ...interface{}) {
+	if c.err == nil {
+		c.err = errors.New(fmt.Sprintf("cpu error: "+format, a...))
 	}
 }
 
+// tick advances the system by the M-cycle of a memory access.
+func (c *CPU) tick() {
+	c.ticked += 4
+
+	if c.Tick != nil {
+		c.Tick(4)
+	}
+}
+
+func (c *CPU) fetch(addr uint16) uint8 {
+	c.tick()
+	return c.Hardware.Fetch(addr)
+}
+
+func (c *CPU) read(addr uint16) uint8 {
+	c.tick()
+	return c.Hardware.Read(addr)
+}
+
+func (c *CPU) write(addr uint16, val uint8) {
+	c.tick()
+	c.Hardware.Write(addr, val)
+}
+
+func (c *CPU) read16(addr uint16) uint16 {
+	lo := c.read(addr)
+	hi := c.read(addr + 1)
+	return (uint16(hi) << 8) | uint16(lo)
+}
+
+func (c *CPU) write16(addr uint16, val uint16) {
+	c.write(addr, uint8(val))
+	c.write(addr+1, uint8(val>>8))
+}
+
+// push pushes val on the stack as the cpu does, decrementing SP in an internal
+// M-cycle and then writing the high byte first.
+func (c *CPU) push(val uint16) {
+	c.tick()
+
+	c.SP--
+	c.write(c.SP, uint8(val>>8))
+	c.SP--
+	c.write(c.SP, uint8(val))
+}
+
 func (c *CPU) FetchImmediate8() uint8 {
 	c.PC++
-	return c.Hardware.Read(c.PC)
+	return c.read(c.PC)
 }
 
 func (c *CPU) FetchImmediate16() uint16 {
@@ -140,21 +172,21 @@ func (c *CPU) FetchImmediate16() uint16 {
 	return (uint16(hi) << 8) | uint16(lo)
 }
 
-func (c *CPU) CheckCondition(cond string) bool {
-	var res bool
-
-	switch cond {
-	case "NZ":
-		res = !c.F.Zero
-	case "Z":
-		res = c.F.Zero
-	case "NC":
-		res = !c.F.Carry
-	case "C":
-		res = c.F.Carry
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid condition %s", cond)))
-	}
-
-	return res
+func (c *CPU) SerializeState(s *state.Chunk) {
+	f := c.F.Read()
+
+	s.Uint8(&c.A)
+	s.Uint8(&f)
+	s.Uint8(&c.B)
+	s.Uint8(&c.C)
+	s.Uint8(&c.D)
+	s.Uint8(&c.E)
+	s.Uint8(&c.H)
+	s.Uint8(&c.L)
+	s.Uint16(&c.SP)
+	s.Uint16(&c.PC)
+	s.Bool(&c.IsNextInstructionPrefixed)
+	s.Bool(&c.Locked)
+
+	c.F.Write(f)
 }
@@ -1,6 +1,10 @@
 package cpu
 
 import (
+	"fmt"
+	"testing"
+	"time"
+
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware"
 )
 
@@ -24,3 +28,230 @@ func NewTestCPU(opCode uint8) *CPU {
 
 	return cpu
 }
+
+// benchCode fills the work ram with a counter, swapped, in a loop:
+//
+//	0100 LD HL,$C000
+//	0103 LD C,$00
+//	0105 LD A,C
+//	0106 SWAP A
+//	0108 LD (HL+),A
+//	0109 INC C
+//	010A JR NZ,$0105
+//	010C JR $0100
+var benchCode = []uint8{0x21, 0x00, 0xc0, 0x0e, 0x00, 0x79, 0xcb, 0x37, 0x22, 0x0c, 0x20, 0xf9, 0x18, 0xf2}
+
+func BenchmarkCPU_ExecuteNextInstruction(b *testing.B) {
+	hwe := hardware.NewHardware()
+	copy(hwe.Cartrdige.Bank[0x0100:], benchCode)
+
+	cpu := NewCPU(hwe)
+	cpu.PC = 0x0100
+
+	cycles := 0
+	start := time.Now()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n, err := cpu.ExecuteNextInstruction()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		cycles += n
+	}
+
+	b.ReportMetric(float64(cycles)/time.Since(start).Seconds()/1e6, "MHz")
+}
+
+// testOpCode executes an instruction on a test cpu, once with all the flags
+// set and once with them cleared. It checks the cycles it took against the
+// cycle tables, the flags against the ones the instruction sets, resets or
+// keeps, and PC against the instruction length unless it jumps.
+func testOpCode(t *testing.T, prefixed bool, opCode uint8) {
+	if !prefixed && opCode == 0xcb {
+		// the prefix is executed with the instruction after it, BIT 1,A
+		prefixed, opCode = true, 0x4f
+	}
+
+	for _, set := range []bool{true, false} {
+		cpu := NewTestCPU(opCode)
+		inst := Instructions[opCode]
+
+		if prefixed {
+			cpu = NewTestCPU(0xcb)
+			cpu.Hardware.Cartrdige.Bank[0x0001] = opCode
+			inst = PrefixedInstructions[opCode]
+		}
+
+		cpu.F.Zero, cpu.F.Subtract, cpu.F.HalfCarry, cpu.F.Carry = set, set, set, set
+		pc := cpu.PC
+
+		cycles, err := cpu.ExecuteNextInstruction()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if inst.Name == "UNUSED" {
+			if !cpu.Locked {
+				t.Error("lock up error: want locked cpu")
+			}
+
+			return
+		}
+
+		want := []int{inst.CyclesBranch, inst.CyclesNoBranch}
+		if cycles != want[0] && cycles != want[1] {
+			t.Errorf("cycles error: want %v, got %d", want, cycles)
+		}
+
+		flags := map[string]bool{"Z": cpu.F.Zero, "N": cpu.F.Subtract, "H": cpu.F.HalfCarry, "C": cpu.F.Carry}
+		for name, got := range flags {
+			switch inst.Flags[name] {
+			case "-":
+				if got != set {
+					t.Errorf("flag %s error: want unchanged %t, got %t", name, set, got)
+				}
+			case "0", "1":
+				if got != (inst.Flags[name] == "1") {
+					t.Errorf("flag %s error: want %s, got %t", name, inst.Flags[name], got)
+				}
+			}
+		}
+
+		switch inst.Name {
+		case "JP", "JR", "CALL", "RET", "RETI", "RST":
+		default:
+			if length := instructionLength(inst, prefixed); cpu.PC != pc+length {
+				t.Errorf("pc error: want %#04x, got %#04x", pc+length, cpu.PC)
+			}
+		}
+	}
+}
+
+// instructionLength returns the bytes of an instruction, opcode and prefix
+// included.
+func instructionLength(inst *Instruction, prefixed bool) uint16 {
+	length := uint16(1)
+	if prefixed {
+		length++
+	}
+
+	for _, param := range inst.Parameters {
+		switch param {
+		case "u8", "i8", "(FF00+u8)", "SP+i8":
+			length++
+		case "u16", "(u16)":
+			length += 2
+		}
+	}
+
+	return length
+}
+
+func sum(values []int) int {
+	res := 0
+	for _, v := range values {
+		res += v
+	}
+
+	return res
+}
+
+func TestCPU_Tick(t *testing.T) {
+	tests := []struct {
+		name string
+		code []uint8
+		// ticks are the cycles of every tick, written is the tick after
+		// which 0xc000 is written, 0 if never
+		ticks   []int
+		written int
+	}{
+		{"LD (HL),A", []uint8{0x77}, []int{4, 4}, 2},
+		{"INC BC", []uint8{0x03}, []int{4, 4}, 0},
+		{"LD (u16),A", []uint8{0xea, 0x00, 0xc0}, []int{4, 4, 4, 4}, 4},
+		{"CALL u16", []uint8{0xcd, 0x00, 0x02}, []int{4, 4, 4, 4, 4, 4}, 6},
+		{"PUSH AF", []uint8{0xf5}, []int{4, 4, 4, 4}, 4},
+		{"BIT 7,H", []uint8{0xcb, 0x7c}, []int{4, 4}, 0},
+		{"BIT 0,(HL)", []uint8{0xcb, 0x46}, []int{4, 4, 4}, 0},
+		{"RL (HL)", []uint8{0xcb, 0x16}, []int{4, 4, 4, 4}, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hwe := hardware.NewHardware()
+			copy(hwe.Cartrdige.Bank[:], test.code)
+
+			cpu := NewCPU(hwe)
+			cpu.A = 0x42
+			cpu.F.Carry = true
+			cpu.WriteHL(0xc000)
+			cpu.SP = 0xc002
+
+			var ticks []int
+			written := 0
+
+			cpu.Tick = func(cycles int) {
+				if written == 0 && hwe.Read(0xc000) != 0 {
+					written = len(ticks)
+				}
+
+				ticks = append(ticks, cycles)
+			}
+
+			cycles, err := cpu.ExecuteNextInstruction()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if written == 0 && hwe.Read(0xc000) != 0 {
+				written = len(ticks)
+			}
+
+			if fmt.Sprint(ticks) != fmt.Sprint(test.ticks) {
+				t.Errorf("ticks error: want %v, got %v", test.ticks, ticks)
+			}
+
+			if written != test.written {
+				t.Errorf("write error: want after tick %d, got %d", test.written, written)
+			}
+
+			wantCycles := InstructionCycles[test.code[0]]
+			if test.code[0] == 0xcb {
+				wantCycles = PrefixedInstructionCycles[test.code[1]]
+			}
+
+			if cycles != wantCycles || cycles != sum(ticks) {
+				t.Errorf("cycles error: want %d, got %d, ticked %d", wantCycles, cycles, sum(ticks))
+			}
+		})
+	}
+}
+
+func TestCPU_LockUp(t *testing.T) {
+	cpu := NewTestCPU(0xd3)
+
+	for i := 0; i < 3; i++ {
+		cycles, err := cpu.ExecuteNextInstruction()
+		if err != nil || cycles != 4 {
+			t.Errorf("execute error: want 4 cycles, got %d (%v)", cycles, err)
+		}
+	}
+
+	if !cpu.Locked || cpu.PC != 0x0000 {
+		t.Errorf("lock up error: locked %t, PC %#04x", cpu.Locked, cpu.PC)
+	}
+}
+
+func TestCPU_InvalidInstruction(t *testing.T) {
+	// HALT is not implemented
+	cpu := NewTestCPU(0x76)
+
+	if _, err := cpu.ExecuteNextInstruction(); err == nil {
+		t.Error("invalid instruction error: want error, got nil")
+	}
+
+	if cpu.PC != 0x0000 {
+		t.Errorf("PC error: want 0x0000, got %#04x", cpu.PC)
+	}
+}
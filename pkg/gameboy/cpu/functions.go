@@ -1,529 +1,639 @@
 package cpu
 
 import (
-	"errors"
-	"fmt"
 	"strconv"
+	"strings"
 )
 
-func (c *CPU) PrefixInst(inst *Instruction) int {
-	c.IsNextInstructionPrefixed = true
-	c.PC++
+// invalidBits returns a handler failing as the instruction has an operand
+// size not supported.
+func invalidBits(inst *Instruction) handler {
+	return func(c *CPU) int {
+		c.fail("invalid bits %d", inst.Bits)
+		return 0
+	}
+}
+
+func prefixInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	return inst.CyclesBranch
+	return func(c *CPU) int {
+		c.IsNextInstructionPrefixed = true
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) NopInst(inst *Instruction) int {
-	c.PC++
+func nopInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	return inst.CyclesBranch
+	return func(c *CPU) int {
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) LdInst(inst *Instruction) int {
+func ldInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
 	switch inst.Bits {
 	case 8:
-		srcVal := c.ReadParameter(inst.Parameters[1])
-		c.WriteParameter(inst.Parameters[0], srcVal)
+		dst, src := newOperand8(inst.Parameters[0]), newOperand8(inst.Parameters[1])
+
+		return func(c *CPU) int {
+			dst.write(c, src.read(c))
+			c.PC++
+
+			return cycles
+		}
 	case 16:
-		srcVal := c.ReadParameter16(inst.Parameters[1])
-		c.WriteParameter16(inst.Parameters[0], srcVal)
+		dst, src := newOperand16(inst.Parameters[0]), newOperand16(inst.Parameters[1])
+		setsFlags := inst.Parameters[1] == "SP+i8"
 
-		if inst.Parameters[1] == "SP+i8" {
-			c.F.Zero = false
-			c.F.Subtract = false
-			c.F.HalfCarry = srcVal>>3 != 0x0
-			c.F.Carry = srcVal>>7 != 0x0
+		return func(c *CPU) int {
+			srcVal := src.read(c)
+			dst.write(c, srcVal)
+
+			if setsFlags {
+				c.F.Zero = false
+				c.F.Subtract = false
+				c.F.HalfCarry = srcVal>>3 != 0x0
+				c.F.Carry = srcVal>>7 != 0x0
+			}
+
+			c.PC++
+
+			return cycles
 		}
 	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid bits %d", inst.Bits)))
+		return invalidBits(inst)
 	}
-
-	c.PC++
-
-	return inst.CyclesBranch
 }
 
-func (c *CPU) XorInst(inst *Instruction) int {
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	res := c.A ^ srcVal
+func xorInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	src := newOperand8(inst.Parameters[1])
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = false
+	return func(c *CPU) int {
+		res := c.A ^ src.read(c)
+
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = false
 
-	c.A = res
+		c.A = res
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) BitInst(inst *Instruction) int {
+func bitInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
 	bit, err := strconv.Atoi(inst.Parameters[0])
 	if err != nil {
 		panic(err)
 	}
 
 	ubit := uint8(bit)
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	test := (srcVal >> ubit) & 0x1
+	src := newOperand8(inst.Parameters[1])
 
-	c.F.Zero = test == 0x0
-	c.F.Subtract = false
-	c.F.HalfCarry = true
+	return func(c *CPU) int {
+		test := (src.read(c) >> ubit) & 0x1
 
-	c.PC++
+		c.F.Zero = test == 0x0
+		c.F.Subtract = false
+		c.F.HalfCarry = true
+
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) JrInst(inst *Instruction) int {
-	var cond bool
+// branch returns the condition of a conditional jump, call or return and the
+// parameter of its target, the condition is nil for unconditional ones.
+func branch(inst *Instruction, targetIndex int) (func(c *CPU) bool, string) {
+	if len(inst.Parameters) > targetIndex {
+		return newCondition(inst.Parameters[0]), inst.Parameters[targetIndex]
+	}
 
-	if len(inst.Parameters) == 1 {
-		cond = true
-	} else {
-		cond = c.CheckCondition(inst.Parameters[0])
+	if targetIndex == 0 {
+		return nil, ""
 	}
 
-	if cond {
-		var srcParam string
+	return nil, inst.Parameters[0]
+}
 
-		if len(inst.Parameters) == 1 {
-			srcParam = inst.Parameters[0]
+func jrInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	cond, target := branch(inst, 1)
+	offset := newOperand16(target)
+
+	return func(c *CPU) int {
+		if cond == nil || cond(c) {
+			val := offset.read(c)
+			c.PC += val
 		} else {
-			srcParam = inst.Parameters[1]
+			c.PC++
 		}
 
-		c.PC += c.ReadParameter16(srcParam)
-	} else {
 		c.PC++
-	}
 
-	c.PC++
-
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) IncInst(inst *Instruction) int {
+func incInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
 	switch inst.Bits {
 	case 8:
-		srcVal := c.ReadParameter(inst.Parameters[0])
-		res := srcVal + 1
+		op := newOperand8(inst.Parameters[0])
 
-		c.F.Zero = res == 0x0
-		c.F.Subtract = false
-		c.F.HalfCarry = res&0xf == 0xf
+		return func(c *CPU) int {
+			res := op.read(c) + 1
 
-		c.WriteParameter(inst.Parameters[0], res)
-	case 16:
-		srcVal := c.ReadParameter16(inst.Parameters[0])
-		res := srcVal + 1
+			c.F.Zero = res == 0x0
+			c.F.Subtract = false
+			c.F.HalfCarry = res&0xf == 0xf
 
-		c.WriteParameter16(inst.Parameters[0], res)
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid bits %d", inst.Bits)))
-	}
+			op.write(c, res)
 
-	c.PC++
+			c.PC++
 
-	return inst.CyclesBranch
-}
+			return cycles
+		}
+	case 16:
+		op := newOperand16(inst.Parameters[0])
 
-func (c *CPU) CallInst(inst *Instruction) int {
-	var cond bool
+		return func(c *CPU) int {
+			op.write(c, op.read(c)+1)
 
-	if len(inst.Parameters) == 1 {
-		cond = true
-	} else {
-		cond = c.CheckCondition(inst.Parameters[0])
+			c.PC++
+
+			return cycles
+		}
+	default:
+		return invalidBits(inst)
 	}
+}
 
-	if cond {
-		var srcParam string
+func callInst(inst *Instruction) handler {
+	cyclesBranch, cyclesNoBranch := inst.CyclesBranch, inst.CyclesNoBranch
+	cond, target := branch(inst, 1)
+	addr := newOperand16(target)
 
-		if len(inst.Parameters) == 1 {
-			srcParam = inst.Parameters[0]
-		} else {
-			srcParam = inst.Parameters[1]
-		}
+	return func(c *CPU) int {
+		if cond != nil && !cond(c) {
+			c.PC++
 
-		srcVal := c.ReadParameter16(srcParam)
-		nextPC := c.PC + 1
+			return cyclesNoBranch
+		}
 
-		c.Hardware.Write(c.SP-2, uint8(nextPC&0xff))
-		c.Hardware.Write(c.SP-1, uint8(nextPC>>8))
+		srcVal := addr.read(c)
 
-		c.SP -= 2
+		c.push(c.PC + 1)
 		c.PC = srcVal
 
-		return inst.CyclesBranch
-	} else {
-		c.PC++
-
-		return inst.CyclesNoBranch
+		return cyclesBranch
 	}
 }
 
-func (c *CPU) PushInst(inst *Instruction) int {
-	srcVal := c.ReadParameter16(inst.Parameters[0])
+func pushInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	src := newOperand16(inst.Parameters[0])
 
-	c.SP -= 2
-	c.Hardware.Write16(c.SP, srcVal)
+	return func(c *CPU) int {
+		c.push(src.read(c))
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) RlInst(inst *Instruction) int {
-	srcVal := c.ReadParameter(inst.Parameters[0])
+func rlInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	op := newOperand8(inst.Parameters[0])
 
-	val := srcVal << 1
+	return func(c *CPU) int {
+		srcVal := op.read(c)
 
-	if c.F.Carry {
-		val = val | 0x1
-	}
+		val := srcVal << 1
+
+		if c.F.Carry {
+			val = val | 0x1
+		}
 
-	c.F.Zero = val == 0x0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = srcVal>>7 != 0x0
+		c.F.Zero = val == 0x0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = srcVal>>7 != 0x0
 
-	c.WriteParameter(inst.Parameters[0], val)
+		op.write(c, val)
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) RlaInst(inst *Instruction) int {
-	val := c.A << 1
+func rlaInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	if c.F.Carry {
-		val = val | 0x1
-	}
+	return func(c *CPU) int {
+		val := c.A << 1
 
-	c.F.Zero = false
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = c.A>>7 != 0x0
+		if c.F.Carry {
+			val = val | 0x1
+		}
 
-	c.A = val
+		c.F.Zero = false
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = c.A>>7 != 0x0
 
-	c.PC++
+		c.A = val
 
-	return inst.CyclesBranch
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) PopInst(inst *Instruction) int {
-	val := c.Hardware.Read16(c.SP)
+func popInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	dst := newOperand16(inst.Parameters[0])
 
-	c.WriteParameter16(inst.Parameters[0], val)
-	c.SP += 2
+	return func(c *CPU) int {
+		dst.write(c, c.read16(c.SP))
+		c.SP += 2
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) DecInst(inst *Instruction) int {
+func decInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
 	switch inst.Bits {
 	case 8:
-		srcVal := c.ReadParameter(inst.Parameters[0])
-		res := srcVal - 1
+		op := newOperand8(inst.Parameters[0])
 
-		c.F.Zero = res == 0x0
-		c.F.Subtract = true
-		c.F.HalfCarry = res&0xf == 0xf
+		return func(c *CPU) int {
+			res := op.read(c) - 1
+
+			c.F.Zero = res == 0x0
+			c.F.Subtract = true
+			c.F.HalfCarry = res&0xf == 0xf
+
+			op.write(c, res)
+
+			c.PC++
 
-		c.WriteParameter(inst.Parameters[0], res)
+			return cycles
+		}
 	case 16:
-		srcVal := c.ReadParameter16(inst.Parameters[0])
-		res := srcVal - 1
+		op := newOperand16(inst.Parameters[0])
 
-		c.WriteParameter16(inst.Parameters[0], res)
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid bits %d", inst.Bits)))
-	}
+		return func(c *CPU) int {
+			op.write(c, op.read(c)-1)
 
-	c.PC++
+			c.PC++
 
-	return inst.CyclesBranch
+			return cycles
+		}
+	default:
+		return invalidBits(inst)
+	}
 }
 
-func (c *CPU) RetInst(inst *Instruction) int {
-	var cond bool
+func retInst(inst *Instruction) handler {
+	cyclesBranch, cyclesNoBranch := inst.CyclesBranch, inst.CyclesNoBranch
+	cond, _ := branch(inst, 0)
 
-	if len(inst.Parameters) == 1 {
-		cond = c.CheckCondition(inst.Parameters[0])
-	} else {
-		cond = true
-	}
+	return func(c *CPU) int {
+		if cond != nil && !cond(c) {
+			c.PC++
 
-	if cond {
-		c.PC = c.Hardware.Read16(c.SP)
-		c.SP += 2
+			return cyclesNoBranch
+		}
 
-		return inst.CyclesBranch
-	} else {
-		c.PC++
+		c.PC = c.read16(c.SP)
+		c.SP += 2
 
-		return inst.CyclesNoBranch
+		return cyclesBranch
 	}
 }
 
-func (c *CPU) CpInst(inst *Instruction) int {
-	tarVal := c.ReadParameter(inst.Parameters[0])
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	res := tarVal - srcVal
+func cpInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	tar, src := newOperand8(inst.Parameters[0]), newOperand8(inst.Parameters[1])
 
-	c.F.Zero = res == 0x0
-	c.F.Subtract = true
-	c.F.HalfCarry = res&0xf == 0xf
-	c.F.Carry = res>>7 != 0x0
+	return func(c *CPU) int {
+		tarVal := tar.read(c)
+		res := tarVal - src.read(c)
 
-	c.PC++
+		c.F.Zero = res == 0x0
+		c.F.Subtract = true
+		c.F.HalfCarry = res&0xf == 0xf
+		c.F.Carry = res>>7 != 0x0
+
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) SubInst(inst *Instruction) int {
-	tarVal := c.ReadParameter(inst.Parameters[0])
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	res := tarVal - srcVal
+func subInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	tar, src := newOperand8(inst.Parameters[0]), newOperand8(inst.Parameters[1])
 
-	c.F.Zero = res == 0x0
-	c.F.Subtract = true
-	c.F.HalfCarry = res&0xf < 0xf
-	c.F.Carry = res < 0
+	return func(c *CPU) int {
+		tarVal := tar.read(c)
+		res := tarVal - src.read(c)
 
-	c.WriteParameter(inst.Parameters[0], res)
+		c.F.Zero = res == 0x0
+		c.F.Subtract = true
+		c.F.HalfCarry = res&0xf < 0xf
+		c.F.Carry = res < 0
 
-	c.PC++
+		tar.write(c, res)
+
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) AddInst(inst *Instruction) int {
+func addInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
 	switch inst.Bits {
 	case 8:
-		tarVal := c.ReadParameter(inst.Parameters[0])
-		srcVal := c.ReadParameter(inst.Parameters[1])
-		res := tarVal + srcVal
-
-		c.F.Zero = res == 0x0
-		c.F.Subtract = false
-		c.F.HalfCarry = res&0xf > 0xf
-		c.F.Carry = res > 0xff
+		tar, src := newOperand8(inst.Parameters[0]), newOperand8(inst.Parameters[1])
 
-		c.WriteParameter(inst.Parameters[0], res)
-	case 16:
-		tarVal := c.ReadParameter16(inst.Parameters[0])
-		srcVal := c.ReadParameter16(inst.Parameters[1])
-		res := tarVal + srcVal
+		return func(c *CPU) int {
+			tarVal, srcVal := tar.read(c), src.read(c)
+			res := tarVal + srcVal
 
-		c.F.Zero = res == 0x0
-		c.F.Subtract = false
-		c.F.HalfCarry = res&0xf > 0xf
-		c.F.Carry = res > 0xff
+			c.F.Zero = res == 0x0
+			c.F.Subtract = false
+			c.F.HalfCarry = tarVal&0xf+srcVal&0xf > 0xf
+			c.F.Carry = uint16(tarVal)+uint16(srcVal) > 0xff
 
-		c.WriteParameter16(inst.Parameters[0], res)
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: invalid bits %d", inst.Bits)))
-	}
+			tar.write(c, res)
 
-	c.PC++
+			c.PC++
 
-	return inst.CyclesBranch
-}
+			return cycles
+		}
+	case 16:
+		tar, src := newOperand16(inst.Parameters[0]), newOperand16(inst.Parameters[1])
+		// ADD SP,i8 carries from the low byte and resets Z, ADD HL keeps Z
+		offset := inst.Parameters[1] == "i8"
+
+		return func(c *CPU) int {
+			tarVal, srcVal := tar.read(c), src.read(c)
+			res := tarVal + srcVal
+
+			if offset {
+				c.F.Zero = false
+				c.F.HalfCarry = tarVal&0xf+srcVal&0xf > 0xf
+				c.F.Carry = tarVal&0xff+srcVal&0xff > 0xff
+			} else {
+				c.F.HalfCarry = tarVal&0xfff+srcVal&0xfff > 0xfff
+				c.F.Carry = uint32(tarVal)+uint32(srcVal) > 0xffff
+			}
 
-func (c *CPU) JpInst(inst *Instruction) int {
-	var cond bool
+			c.F.Subtract = false
 
-	if len(inst.Parameters) == 1 {
-		cond = true
-	} else {
-		cond = c.CheckCondition(inst.Parameters[0])
-	}
+			tar.write(c, res)
 
-	if cond {
-		var srcParam string
+			c.PC++
 
-		if len(inst.Parameters) == 1 {
-			srcParam = inst.Parameters[0]
-		} else {
-			srcParam = inst.Parameters[1]
+			return cycles
 		}
+	default:
+		return invalidBits(inst)
+	}
+}
 
-		srcVal := c.ReadParameter16(srcParam)
+func jpInst(inst *Instruction) handler {
+	cyclesBranch, cyclesNoBranch := inst.CyclesBranch, inst.CyclesNoBranch
+	cond, target := branch(inst, 1)
+	addr := newOperand16(target)
 
-		c.PC = srcVal
+	return func(c *CPU) int {
+		if cond != nil && !cond(c) {
+			c.PC++
 
-		return inst.CyclesBranch
-	} else {
-		c.PC++
+			return cyclesNoBranch
+		}
+
+		c.PC = addr.read(c)
 
-		return inst.CyclesNoBranch
+		return cyclesBranch
 	}
 }
 
-func (c *CPU) DiInst(inst *Instruction) int {
-	// todo disable interrupts
+func diInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	c.PC++
+	return func(c *CPU) int {
+		// todo disable interrupts
+
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) OrInst(inst *Instruction) int {
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	res := c.A | srcVal
+func orInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	src := newOperand8(inst.Parameters[1])
+
+	return func(c *CPU) int {
+		res := c.A | src.read(c)
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = false
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = false
 
-	c.A = res
+		c.A = res
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) AndInst(inst *Instruction) int {
-	srcVal := c.ReadParameter(inst.Parameters[1])
-	res := c.A | srcVal
+func andInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	src := newOperand8(inst.Parameters[1])
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = true
-	c.F.Carry = false
+	return func(c *CPU) int {
+		res := c.A | src.read(c)
 
-	c.A = res
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = true
+		c.F.Carry = false
 
-	c.PC++
+		c.A = res
 
-	return inst.CyclesBranch
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) CplInst(inst *Instruction) int {
-	c.A = ^c.A
+func cplInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	c.F.Subtract = true
-	c.F.HalfCarry = true
+	return func(c *CPU) int {
+		c.A = ^c.A
 
-	c.PC++
+		c.F.Subtract = true
+		c.F.HalfCarry = true
 
-	return inst.CyclesBranch
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) EiInst(inst *Instruction) int {
-	// todo enable interrupts
+func eiInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	c.PC++
+	return func(c *CPU) int {
+		// todo enable interrupts
 
-	return inst.CyclesBranch
+		c.PC++
+
+		return cycles
+	}
 }
 
-func (c *CPU) SwapInst(inst *Instruction) int {
-	val := c.ReadParameter(inst.Parameters[0])
-	res := (val&0xF0)>>4 ^ ((val & 0x0F) << 4)
+func swapInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	op := newOperand8(inst.Parameters[0])
 
-	c.WriteParameter(inst.Parameters[0], val)
+	return func(c *CPU) int {
+		val := op.read(c)
+		res := (val&0xF0)>>4 ^ ((val & 0x0F) << 4)
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = false
+		op.write(c, val)
 
-	c.PC++
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = false
+
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) RstInst(inst *Instruction) int {
-	// todo
-	switch inst.Parameters[0] {
-	case "28h":
+func rstInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
+	vector, err := strconv.ParseUint(strings.TrimSuffix(inst.Parameters[0], "h"), 16, 16)
+	if err != nil {
+		panic(err)
 	}
 
-	c.PC++
+	return func(c *CPU) int {
+		c.push(c.PC + 1)
+		c.PC = uint16(vector)
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
 
-func (c *CPU) SrlInst(inst *Instruction) int {
-	val := c.ReadParameter(inst.Parameters[0])
-	res := val >> 1
-
-	c.WriteParameter(inst.Parameters[0], res)
+func srlInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	op := newOperand8(inst.Parameters[0])
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = res&0x01 != 0
+	return func(c *CPU) int {
+		res := op.read(c) >> 1
 
-	c.PC++
+		op.write(c, res)
 
-	return inst.CyclesBranch
-}
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = res&0x01 != 0
 
-func (c *CPU) RrInst(inst *Instruction) int {
-	val := c.ReadParameter(inst.Parameters[0])
+		c.PC++
 
-	var ci uint8
-	if c.F.Carry {
-		ci = 1
-	} else {
-		ci = 0
+		return cycles
 	}
+}
 
-	res := (val >> 1) | (ci << 7)
+func rrInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+	op := newOperand8(inst.Parameters[0])
 
-	c.WriteParameter(inst.Parameters[0], res)
+	return func(c *CPU) int {
+		val := op.read(c)
 
-	c.F.Zero = res == 0
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = val&0x01 != 0
+		var ci uint8
+		if c.F.Carry {
+			ci = 1
+		} else {
+			ci = 0
+		}
 
-	c.PC++
+		res := (val >> 1) | (ci << 7)
 
-	return inst.CyclesBranch
-}
+		op.write(c, res)
 
-func (c *CPU) RraInst(inst *Instruction) int {
-	val := c.A
+		c.F.Zero = res == 0
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = val&0x01 != 0
+
+		c.PC++
 
-	var ci uint8
-	if c.F.Carry {
-		ci = 1
-	} else {
-		ci = 0
+		return cycles
 	}
+}
 
-	res := (val >> 1) | (ci << 7)
+func rraInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
 
-	c.A = res
+	return func(c *CPU) int {
+		val := c.A
 
-	c.F.Zero = false
-	c.F.Subtract = false
-	c.F.HalfCarry = false
-	c.F.Carry = val&0x01 != 0
+		var ci uint8
+		if c.F.Carry {
+			ci = 1
+		} else {
+			ci = 0
+		}
 
-	c.PC++
+		res := (val >> 1) | (ci << 7)
 
-	return inst.CyclesBranch
-}
+		c.A = res
 
-func (c *CPU) AdcInst(inst *Instruction) int {
-	// todo
+		c.F.Zero = false
+		c.F.Subtract = false
+		c.F.HalfCarry = false
+		c.F.Carry = val&0x01 != 0
 
-	c.PC++
+		c.PC++
 
-	return inst.CyclesBranch
+		return cycles
+	}
 }
@@ -179,6 +179,30 @@ func TestCPU_AddInst(t *testing.T) {
 	}
 }
 
+func TestCPU_AddInstFlags(t *testing.T) {
+	// ADD HL,BC carries from bits 11 and 15 and keeps Z
+	cpu := NewTestCPU(0x09)
+	cpu.WriteHL(0x8800)
+	cpu.B, cpu.C = 0x88, 0x00
+	cpu.F.Zero = true
+
+	cpu.ExecuteNextInstruction()
+
+	if cpu.ReadHL() != 0x1000 || !cpu.F.HalfCarry || !cpu.F.Carry || !cpu.F.Zero || cpu.F.Subtract {
+		t.Errorf("ADD HL,BC error: got HL %#04x, flags %+v", cpu.ReadHL(), cpu.F)
+	}
+
+	// ADD A,B carries from bits 3 and 7
+	cpu = NewTestCPU(0x80)
+	cpu.A, cpu.B = 0x88, 0x88
+
+	cpu.ExecuteNextInstruction()
+
+	if cpu.A != 0x10 || !cpu.F.HalfCarry || !cpu.F.Carry || cpu.F.Zero {
+		t.Errorf("ADD A,B error: got A %#02x, flags %+v", cpu.A, cpu.F)
+	}
+}
+
 func TestCPU_JpInst(t *testing.T) {
 	for opCode, inst := range Instructions {
 		if inst.Name == "JP" {
@@ -0,0 +1,30 @@
+package cpu
+
+import "github.com/adnsio/gbemu/pkg/gameboy/logger"
+
+// handler executes an instruction and returns the cycles it took. The
+// handlers and prefixedHandlers tables, indexed by opcode, are generated by
+// instgen: every instruction is built by the function named after it, as
+// ldInst for LD, with the parameters already resolved.
+type handler func(c *CPU) int
+
+// invalidInst builds the handler of the instructions not implemented.
+func invalidInst(inst *Instruction) handler {
+	return func(c *CPU) int {
+		c.fail("invalid instruction %#02x \"%s\"", inst.OpCode, inst.Description)
+		return 0
+	}
+}
+
+// unusedInst builds the handler of the opcodes not used by the cpu, they lock
+// it up.
+func unusedInst(inst *Instruction) handler {
+	cycles := inst.CyclesBranch
+
+	return func(c *CPU) int {
+		c.Log.Logf(logger.CPU, logger.Warn, "locked up by opcode %#02x at %#04x", inst.OpCode, c.PC)
+		c.Locked = true
+
+		return cycles
+	}
+}
@@ -0,0 +1,24 @@
+package cpu
+
+//go:generate go run github.com/adnsio/gbemu/cmd/instgen
+
+type Instruction struct {
+	OpCode         uint8
+	Name           string
+	Description    string
+	Parameters     []string
+	Flags          map[string]string
+	Bits           int
+	CyclesBranch   int
+	CyclesNoBranch int
+}
+
+// GetInstruction returns the instruction of an opcode, every opcode has one,
+// the unused ones are named UNUSED.
+func (c *CPU) GetInstruction(opCode uint8) *Instruction {
+	return Instructions[opCode]
+}
+
+func (c *CPU) GetPrefixedInstruction(opCode uint8) *Instruction {
+	return PrefixedInstructions[opCode]
+}
@@ -1,553 +1,1102 @@
-package cpu
+// Code generated by instgen from opcodes.json. DO NOT EDIT.
 
-import (
-	"errors"
-	"fmt"
-)
+package cpu
 
-type Instruction struct {
-	OpCode         uint8
-	Name           string
-	Description    string
-	Parameters     []string
-	Flags          map[string]string
-	Bits           int
-	CyclesBranch   int
-	CyclesNoBranch int
+var Instructions = map[uint8]*Instruction{
+	0x00: {OpCode: 0x00, Name: "NOP", Description: "NOP", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x01: {OpCode: 0x01, Name: "LD", Description: "LD BC,u16", Parameters: []string{"BC", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x02: {OpCode: 0x02, Name: "LD", Description: "LD (BC),A", Parameters: []string{"(BC)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x03: {OpCode: 0x03, Name: "INC", Description: "INC BC", Parameters: []string{"BC"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x04: {OpCode: 0x04, Name: "INC", Description: "INC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x05: {OpCode: 0x05, Name: "DEC", Description: "DEC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x06: {OpCode: 0x06, Name: "LD", Description: "LD B,u8", Parameters: []string{"B", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x07: {OpCode: 0x07, Name: "RLCA", Description: "RLCA", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x08: {OpCode: 0x08, Name: "LD", Description: "LD (u16),SP", Parameters: []string{"(u16)", "SP"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 20, CyclesNoBranch: 20},
+	0x09: {OpCode: 0x09, Name: "ADD", Description: "ADD HL,BC", Parameters: []string{"HL", "BC"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0a: {OpCode: 0x0a, Name: "LD", Description: "LD A,(BC)", Parameters: []string{"A", "(BC)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0b: {OpCode: 0x0b, Name: "DEC", Description: "DEC BC", Parameters: []string{"BC"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0c: {OpCode: 0x0c, Name: "INC", Description: "INC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x0d: {OpCode: 0x0d, Name: "DEC", Description: "DEC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x0e: {OpCode: 0x0e, Name: "LD", Description: "LD C,u8", Parameters: []string{"C", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0f: {OpCode: 0x0f, Name: "RRCA", Description: "RRCA", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x10: {OpCode: 0x10, Name: "STOP", Description: "STOP", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x11: {OpCode: 0x11, Name: "LD", Description: "LD DE,u16", Parameters: []string{"DE", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x12: {OpCode: 0x12, Name: "LD", Description: "LD (DE),A", Parameters: []string{"(DE)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x13: {OpCode: 0x13, Name: "INC", Description: "INC DE", Parameters: []string{"DE"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x14: {OpCode: 0x14, Name: "INC", Description: "INC D", Parameters: []string{"D"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x15: {OpCode: 0x15, Name: "DEC", Description: "DEC D", Parameters: []string{"D"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x16: {OpCode: 0x16, Name: "LD", Description: "LD D,u8", Parameters: []string{"D", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x17: {OpCode: 0x17, Name: "RLA", Description: "RLA", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x18: {OpCode: 0x18, Name: "JR", Description: "JR i8", Parameters: []string{"i8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x19: {OpCode: 0x19, Name: "ADD", Description: "ADD HL,DE", Parameters: []string{"HL", "DE"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1a: {OpCode: 0x1a, Name: "LD", Description: "LD A,(DE)", Parameters: []string{"A", "(DE)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1b: {OpCode: 0x1b, Name: "DEC", Description: "DEC DE", Parameters: []string{"DE"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1c: {OpCode: 0x1c, Name: "INC", Description: "INC E", Parameters: []string{"E"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x1d: {OpCode: 0x1d, Name: "DEC", Description: "DEC E", Parameters: []string{"E"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x1e: {OpCode: 0x1e, Name: "LD", Description: "LD E,u8", Parameters: []string{"E", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1f: {OpCode: 0x1f, Name: "RRA", Description: "RRA", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x20: {OpCode: 0x20, Name: "JR", Description: "JR NZ,i8", Parameters: []string{"NZ", "i8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
+	0x21: {OpCode: 0x21, Name: "LD", Description: "LD HL,u16", Parameters: []string{"HL", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x22: {OpCode: 0x22, Name: "LD", Description: "LD (HL+),A", Parameters: []string{"(HL+)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x23: {OpCode: 0x23, Name: "INC", Description: "INC HL", Parameters: []string{"HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x24: {OpCode: 0x24, Name: "INC", Description: "INC H", Parameters: []string{"H"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x25: {OpCode: 0x25, Name: "DEC", Description: "DEC H", Parameters: []string{"H"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x26: {OpCode: 0x26, Name: "LD", Description: "LD H,u8", Parameters: []string{"H", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x27: {OpCode: 0x27, Name: "DAA", Description: "DAA", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x28: {OpCode: 0x28, Name: "JR", Description: "JR Z,i8", Parameters: []string{"Z", "i8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
+	0x29: {OpCode: 0x29, Name: "ADD", Description: "ADD HL,HL", Parameters: []string{"HL", "HL"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2a: {OpCode: 0x2a, Name: "LD", Description: "LD A,(HL+)", Parameters: []string{"A", "(HL+)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2b: {OpCode: 0x2b, Name: "DEC", Description: "DEC HL", Parameters: []string{"HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2c: {OpCode: 0x2c, Name: "INC", Description: "INC L", Parameters: []string{"L"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x2d: {OpCode: 0x2d, Name: "DEC", Description: "DEC L", Parameters: []string{"L"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x2e: {OpCode: 0x2e, Name: "LD", Description: "LD L,u8", Parameters: []string{"L", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2f: {OpCode: 0x2f, Name: "CPL", Description: "CPL", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "1", "N": "1", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x30: {OpCode: 0x30, Name: "JR", Description: "JR NC,i8", Parameters: []string{"NC", "i8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
+	0x31: {OpCode: 0x31, Name: "LD", Description: "LD SP,u16", Parameters: []string{"SP", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x32: {OpCode: 0x32, Name: "LD", Description: "LD (HL-),A", Parameters: []string{"(HL-)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x33: {OpCode: 0x33, Name: "INC", Description: "INC SP", Parameters: []string{"SP"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x34: {OpCode: 0x34, Name: "INC", Description: "INC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x35: {OpCode: 0x35, Name: "DEC", Description: "DEC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x36: {OpCode: 0x36, Name: "LD", Description: "LD (HL),u8", Parameters: []string{"(HL)", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x37: {OpCode: 0x37, Name: "SCF", Description: "SCF", Parameters: []string{}, Flags: map[string]string{"C": "1", "H": "0", "N": "0", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x38: {OpCode: 0x38, Name: "JR", Description: "JR C,i8", Parameters: []string{"C", "i8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
+	0x39: {OpCode: 0x39, Name: "ADD", Description: "ADD HL,SP", Parameters: []string{"HL", "SP"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3a: {OpCode: 0x3a, Name: "LD", Description: "LD A,(HL-)", Parameters: []string{"A", "(HL-)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3b: {OpCode: 0x3b, Name: "DEC", Description: "DEC SP", Parameters: []string{"SP"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3c: {OpCode: 0x3c, Name: "INC", Description: "INC A", Parameters: []string{"A"}, Flags: map[string]string{"C": "-", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x3d: {OpCode: 0x3d, Name: "DEC", Description: "DEC A", Parameters: []string{"A"}, Flags: map[string]string{"C": "-", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x3e: {OpCode: 0x3e, Name: "LD", Description: "LD A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3f: {OpCode: 0x3f, Name: "CCF", Description: "CCF", Parameters: []string{}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x40: {OpCode: 0x40, Name: "LD", Description: "LD B,B", Parameters: []string{"B", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x41: {OpCode: 0x41, Name: "LD", Description: "LD B,C", Parameters: []string{"B", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x42: {OpCode: 0x42, Name: "LD", Description: "LD B,D", Parameters: []string{"B", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x43: {OpCode: 0x43, Name: "LD", Description: "LD B,E", Parameters: []string{"B", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x44: {OpCode: 0x44, Name: "LD", Description: "LD B,H", Parameters: []string{"B", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x45: {OpCode: 0x45, Name: "LD", Description: "LD B,L", Parameters: []string{"B", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x46: {OpCode: 0x46, Name: "LD", Description: "LD B,(HL)", Parameters: []string{"B", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x47: {OpCode: 0x47, Name: "LD", Description: "LD B,A", Parameters: []string{"B", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x48: {OpCode: 0x48, Name: "LD", Description: "LD C,B", Parameters: []string{"C", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x49: {OpCode: 0x49, Name: "LD", Description: "LD C,C", Parameters: []string{"C", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x4a: {OpCode: 0x4a, Name: "LD", Description: "LD C,D", Parameters: []string{"C", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x4b: {OpCode: 0x4b, Name: "LD", Description: "LD C,E", Parameters: []string{"C", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x4c: {OpCode: 0x4c, Name: "LD", Description: "LD C,H", Parameters: []string{"C", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x4d: {OpCode: 0x4d, Name: "LD", Description: "LD C,L", Parameters: []string{"C", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x4e: {OpCode: 0x4e, Name: "LD", Description: "LD C,(HL)", Parameters: []string{"C", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4f: {OpCode: 0x4f, Name: "LD", Description: "LD C,A", Parameters: []string{"C", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x50: {OpCode: 0x50, Name: "LD", Description: "LD D,B", Parameters: []string{"D", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x51: {OpCode: 0x51, Name: "LD", Description: "LD D,C", Parameters: []string{"D", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x52: {OpCode: 0x52, Name: "LD", Description: "LD D,D", Parameters: []string{"D", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x53: {OpCode: 0x53, Name: "LD", Description: "LD D,E", Parameters: []string{"D", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x54: {OpCode: 0x54, Name: "LD", Description: "LD D,H", Parameters: []string{"D", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x55: {OpCode: 0x55, Name: "LD", Description: "LD D,L", Parameters: []string{"D", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x56: {OpCode: 0x56, Name: "LD", Description: "LD D,(HL)", Parameters: []string{"D", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x57: {OpCode: 0x57, Name: "LD", Description: "LD D,A", Parameters: []string{"D", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x58: {OpCode: 0x58, Name: "LD", Description: "LD E,B", Parameters: []string{"E", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x59: {OpCode: 0x59, Name: "LD", Description: "LD E,C", Parameters: []string{"E", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x5a: {OpCode: 0x5a, Name: "LD", Description: "LD E,D", Parameters: []string{"E", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x5b: {OpCode: 0x5b, Name: "LD", Description: "LD E,E", Parameters: []string{"E", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x5c: {OpCode: 0x5c, Name: "LD", Description: "LD E,H", Parameters: []string{"E", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x5d: {OpCode: 0x5d, Name: "LD", Description: "LD E,L", Parameters: []string{"E", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x5e: {OpCode: 0x5e, Name: "LD", Description: "LD E,(HL)", Parameters: []string{"E", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5f: {OpCode: 0x5f, Name: "LD", Description: "LD E,A", Parameters: []string{"E", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x60: {OpCode: 0x60, Name: "LD", Description: "LD H,B", Parameters: []string{"H", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x61: {OpCode: 0x61, Name: "LD", Description: "LD H,C", Parameters: []string{"H", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x62: {OpCode: 0x62, Name: "LD", Description: "LD H,D", Parameters: []string{"H", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x63: {OpCode: 0x63, Name: "LD", Description: "LD H,E", Parameters: []string{"H", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x64: {OpCode: 0x64, Name: "LD", Description: "LD H,H", Parameters: []string{"H", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x65: {OpCode: 0x65, Name: "LD", Description: "LD H,L", Parameters: []string{"H", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x66: {OpCode: 0x66, Name: "LD", Description: "LD H,(HL)", Parameters: []string{"H", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x67: {OpCode: 0x67, Name: "LD", Description: "LD H,A", Parameters: []string{"H", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x68: {OpCode: 0x68, Name: "LD", Description: "LD L,B", Parameters: []string{"L", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x69: {OpCode: 0x69, Name: "LD", Description: "LD L,C", Parameters: []string{"L", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x6a: {OpCode: 0x6a, Name: "LD", Description: "LD L,D", Parameters: []string{"L", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x6b: {OpCode: 0x6b, Name: "LD", Description: "LD L,E", Parameters: []string{"L", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x6c: {OpCode: 0x6c, Name: "LD", Description: "LD L,H", Parameters: []string{"L", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x6d: {OpCode: 0x6d, Name: "LD", Description: "LD L,L", Parameters: []string{"L", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x6e: {OpCode: 0x6e, Name: "LD", Description: "LD L,(HL)", Parameters: []string{"L", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6f: {OpCode: 0x6f, Name: "LD", Description: "LD L,A", Parameters: []string{"L", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x70: {OpCode: 0x70, Name: "LD", Description: "LD (HL),B", Parameters: []string{"(HL)", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x71: {OpCode: 0x71, Name: "LD", Description: "LD (HL),C", Parameters: []string{"(HL)", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x72: {OpCode: 0x72, Name: "LD", Description: "LD (HL),D", Parameters: []string{"(HL)", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x73: {OpCode: 0x73, Name: "LD", Description: "LD (HL),E", Parameters: []string{"(HL)", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x74: {OpCode: 0x74, Name: "LD", Description: "LD (HL),H", Parameters: []string{"(HL)", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x75: {OpCode: 0x75, Name: "LD", Description: "LD (HL),L", Parameters: []string{"(HL)", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x76: {OpCode: 0x76, Name: "HALT", Description: "HALT", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x77: {OpCode: 0x77, Name: "LD", Description: "LD (HL),A", Parameters: []string{"(HL)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x78: {OpCode: 0x78, Name: "LD", Description: "LD A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x79: {OpCode: 0x79, Name: "LD", Description: "LD A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x7a: {OpCode: 0x7a, Name: "LD", Description: "LD A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x7b: {OpCode: 0x7b, Name: "LD", Description: "LD A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x7c: {OpCode: 0x7c, Name: "LD", Description: "LD A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x7d: {OpCode: 0x7d, Name: "LD", Description: "LD A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x7e: {OpCode: 0x7e, Name: "LD", Description: "LD A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7f: {OpCode: 0x7f, Name: "LD", Description: "LD A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x80: {OpCode: 0x80, Name: "ADD", Description: "ADD A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x81: {OpCode: 0x81, Name: "ADD", Description: "ADD A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x82: {OpCode: 0x82, Name: "ADD", Description: "ADD A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x83: {OpCode: 0x83, Name: "ADD", Description: "ADD A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x84: {OpCode: 0x84, Name: "ADD", Description: "ADD A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x85: {OpCode: 0x85, Name: "ADD", Description: "ADD A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x86: {OpCode: 0x86, Name: "ADD", Description: "ADD A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x87: {OpCode: 0x87, Name: "ADD", Description: "ADD A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x88: {OpCode: 0x88, Name: "ADC", Description: "ADC A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x89: {OpCode: 0x89, Name: "ADC", Description: "ADC A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x8a: {OpCode: 0x8a, Name: "ADC", Description: "ADC A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x8b: {OpCode: 0x8b, Name: "ADC", Description: "ADC A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x8c: {OpCode: 0x8c, Name: "ADC", Description: "ADC A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x8d: {OpCode: 0x8d, Name: "ADC", Description: "ADC A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x8e: {OpCode: 0x8e, Name: "ADC", Description: "ADC A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8f: {OpCode: 0x8f, Name: "ADC", Description: "ADC A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x90: {OpCode: 0x90, Name: "SUB", Description: "SUB A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x91: {OpCode: 0x91, Name: "SUB", Description: "SUB A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x92: {OpCode: 0x92, Name: "SUB", Description: "SUB A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x93: {OpCode: 0x93, Name: "SUB", Description: "SUB A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x94: {OpCode: 0x94, Name: "SUB", Description: "SUB A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x95: {OpCode: 0x95, Name: "SUB", Description: "SUB A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x96: {OpCode: 0x96, Name: "SUB", Description: "SUB A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x97: {OpCode: 0x97, Name: "SUB", Description: "SUB A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x98: {OpCode: 0x98, Name: "SBC", Description: "SBC A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x99: {OpCode: 0x99, Name: "SBC", Description: "SBC A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x9a: {OpCode: 0x9a, Name: "SBC", Description: "SBC A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x9b: {OpCode: 0x9b, Name: "SBC", Description: "SBC A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x9c: {OpCode: 0x9c, Name: "SBC", Description: "SBC A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x9d: {OpCode: 0x9d, Name: "SBC", Description: "SBC A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0x9e: {OpCode: 0x9e, Name: "SBC", Description: "SBC A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9f: {OpCode: 0x9f, Name: "SBC", Description: "SBC A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa0: {OpCode: 0xa0, Name: "AND", Description: "AND A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa1: {OpCode: 0xa1, Name: "AND", Description: "AND A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa2: {OpCode: 0xa2, Name: "AND", Description: "AND A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa3: {OpCode: 0xa3, Name: "AND", Description: "AND A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa4: {OpCode: 0xa4, Name: "AND", Description: "AND A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa5: {OpCode: 0xa5, Name: "AND", Description: "AND A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa6: {OpCode: 0xa6, Name: "AND", Description: "AND A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa7: {OpCode: 0xa7, Name: "AND", Description: "AND A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa8: {OpCode: 0xa8, Name: "XOR", Description: "XOR A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xa9: {OpCode: 0xa9, Name: "XOR", Description: "XOR A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xaa: {OpCode: 0xaa, Name: "XOR", Description: "XOR A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xab: {OpCode: 0xab, Name: "XOR", Description: "XOR A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xac: {OpCode: 0xac, Name: "XOR", Description: "XOR A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xad: {OpCode: 0xad, Name: "XOR", Description: "XOR A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xae: {OpCode: 0xae, Name: "XOR", Description: "XOR A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xaf: {OpCode: 0xaf, Name: "XOR", Description: "XOR A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb0: {OpCode: 0xb0, Name: "OR", Description: "OR A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb1: {OpCode: 0xb1, Name: "OR", Description: "OR A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb2: {OpCode: 0xb2, Name: "OR", Description: "OR A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb3: {OpCode: 0xb3, Name: "OR", Description: "OR A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb4: {OpCode: 0xb4, Name: "OR", Description: "OR A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb5: {OpCode: 0xb5, Name: "OR", Description: "OR A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb6: {OpCode: 0xb6, Name: "OR", Description: "OR A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb7: {OpCode: 0xb7, Name: "OR", Description: "OR A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb8: {OpCode: 0xb8, Name: "CP", Description: "CP A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xb9: {OpCode: 0xb9, Name: "CP", Description: "CP A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xba: {OpCode: 0xba, Name: "CP", Description: "CP A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xbb: {OpCode: 0xbb, Name: "CP", Description: "CP A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xbc: {OpCode: 0xbc, Name: "CP", Description: "CP A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xbd: {OpCode: 0xbd, Name: "CP", Description: "CP A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xbe: {OpCode: 0xbe, Name: "CP", Description: "CP A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xbf: {OpCode: 0xbf, Name: "CP", Description: "CP A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xc0: {OpCode: 0xc0, Name: "RET", Description: "RET NZ", Parameters: []string{"NZ"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
+	0xc1: {OpCode: 0xc1, Name: "POP", Description: "POP BC", Parameters: []string{"BC"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xc2: {OpCode: 0xc2, Name: "JP", Description: "JP NZ,u16", Parameters: []string{"NZ", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
+	0xc3: {OpCode: 0xc3, Name: "JP", Description: "JP u16", Parameters: []string{"u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xc4: {OpCode: 0xc4, Name: "CALL", Description: "CALL NZ,u16", Parameters: []string{"NZ", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
+	0xc5: {OpCode: 0xc5, Name: "PUSH", Description: "PUSH BC", Parameters: []string{"BC"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xc6: {OpCode: 0xc6, Name: "ADD", Description: "ADD A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc7: {OpCode: 0xc7, Name: "RST", Description: "RST 00h", Parameters: []string{"00h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xc8: {OpCode: 0xc8, Name: "RET", Description: "RET Z", Parameters: []string{"Z"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
+	0xc9: {OpCode: 0xc9, Name: "RET", Description: "RET", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xca: {OpCode: 0xca, Name: "JP", Description: "JP Z,u16", Parameters: []string{"Z", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
+	0xcb: {OpCode: 0xcb, Name: "PREFIX", Description: "PREFIX CB", Parameters: []string{"CB"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xcc: {OpCode: 0xcc, Name: "CALL", Description: "CALL Z,u16", Parameters: []string{"Z", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
+	0xcd: {OpCode: 0xcd, Name: "CALL", Description: "CALL u16", Parameters: []string{"u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 24},
+	0xce: {OpCode: 0xce, Name: "ADC", Description: "ADC A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xcf: {OpCode: 0xcf, Name: "RST", Description: "RST 08h", Parameters: []string{"08h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xd0: {OpCode: 0xd0, Name: "RET", Description: "RET NC", Parameters: []string{"NC"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
+	0xd1: {OpCode: 0xd1, Name: "POP", Description: "POP DE", Parameters: []string{"DE"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xd2: {OpCode: 0xd2, Name: "JP", Description: "JP NC,u16", Parameters: []string{"NC", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
+	0xd3: {OpCode: 0xd3, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xd4: {OpCode: 0xd4, Name: "CALL", Description: "CALL NC,u16", Parameters: []string{"NC", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
+	0xd5: {OpCode: 0xd5, Name: "PUSH", Description: "PUSH DE", Parameters: []string{"DE"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xd6: {OpCode: 0xd6, Name: "SUB", Description: "SUB A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd7: {OpCode: 0xd7, Name: "RST", Description: "RST 10h", Parameters: []string{"10h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xd8: {OpCode: 0xd8, Name: "RET", Description: "RET C", Parameters: []string{"C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
+	0xd9: {OpCode: 0xd9, Name: "RETI", Description: "RETI", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xda: {OpCode: 0xda, Name: "JP", Description: "JP C,u16", Parameters: []string{"C", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
+	0xdb: {OpCode: 0xdb, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xdc: {OpCode: 0xdc, Name: "CALL", Description: "CALL C,u16", Parameters: []string{"C", "u16"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
+	0xdd: {OpCode: 0xdd, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xde: {OpCode: 0xde, Name: "SBC", Description: "SBC A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xdf: {OpCode: 0xdf, Name: "RST", Description: "RST 18h", Parameters: []string{"18h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xe0: {OpCode: 0xe0, Name: "LD", Description: "LD (FF00+u8),A", Parameters: []string{"(FF00+u8)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xe1: {OpCode: 0xe1, Name: "POP", Description: "POP HL", Parameters: []string{"HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xe2: {OpCode: 0xe2, Name: "LD", Description: "LD (FF00+C),A", Parameters: []string{"(FF00+C)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe3: {OpCode: 0xe3, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xe4: {OpCode: 0xe4, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xe5: {OpCode: 0xe5, Name: "PUSH", Description: "PUSH HL", Parameters: []string{"HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xe6: {OpCode: 0xe6, Name: "AND", Description: "AND A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "0", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe7: {OpCode: 0xe7, Name: "RST", Description: "RST 20h", Parameters: []string{"20h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xe8: {OpCode: 0xe8, Name: "ADD", Description: "ADD SP,i8", Parameters: []string{"SP", "i8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "0"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xe9: {OpCode: 0xe9, Name: "JP", Description: "JP HL", Parameters: []string{"HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xea: {OpCode: 0xea, Name: "LD", Description: "LD (u16),A", Parameters: []string{"(u16)", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xeb: {OpCode: 0xeb, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xec: {OpCode: 0xec, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xed: {OpCode: 0xed, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xee: {OpCode: 0xee, Name: "XOR", Description: "XOR A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xef: {OpCode: 0xef, Name: "RST", Description: "RST 28h", Parameters: []string{"28h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xf0: {OpCode: 0xf0, Name: "LD", Description: "LD A,(FF00+u8)", Parameters: []string{"A", "(FF00+u8)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xf1: {OpCode: 0xf1, Name: "POP", Description: "POP AF", Parameters: []string{"AF"}, Flags: map[string]string{"C": "C", "H": "H", "N": "N", "Z": "Z"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xf2: {OpCode: 0xf2, Name: "LD", Description: "LD A,(FF00+C)", Parameters: []string{"A", "(FF00+C)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf3: {OpCode: 0xf3, Name: "DI", Description: "DI", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xf4: {OpCode: 0xf4, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xf5: {OpCode: 0xf5, Name: "PUSH", Description: "PUSH AF", Parameters: []string{"AF"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xf6: {OpCode: 0xf6, Name: "OR", Description: "OR A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf7: {OpCode: 0xf7, Name: "RST", Description: "RST 30h", Parameters: []string{"30h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xf8: {OpCode: 0xf8, Name: "LD", Description: "LD HL,SP+i8", Parameters: []string{"HL", "SP+i8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "0", "Z": "0"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+	0xf9: {OpCode: 0xf9, Name: "LD", Description: "LD SP,HL", Parameters: []string{"SP", "HL"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfa: {OpCode: 0xfa, Name: "LD", Description: "LD A,(u16)", Parameters: []string{"A", "(u16)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xfb: {OpCode: 0xfb, Name: "EI", Description: "EI", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
+	0xfc: {OpCode: 0xfc, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xfd: {OpCode: 0xfd, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
+	0xfe: {OpCode: 0xfe, Name: "CP", Description: "CP A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "C", "H": "H", "N": "1", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xff: {OpCode: 0xff, Name: "RST", Description: "RST 38h", Parameters: []string{"38h"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
 }
 
-func (c *CPU) GetInstruction(opCode uint8) *Instruction {
-	instruction, ok := Instructions[opCode]
-	if !ok {
-		panic(errors.New(fmt.Sprintf("cpu: invalid opcode %#02x", opCode)))
-	}
+var PrefixedInstructions = map[uint8]*Instruction{
+	0x00: {OpCode: 0x00, Name: "RLC", Description: "RLC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x01: {OpCode: 0x01, Name: "RLC", Description: "RLC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x02: {OpCode: 0x02, Name: "RLC", Description: "RLC D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x03: {OpCode: 0x03, Name: "RLC", Description: "RLC E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x04: {OpCode: 0x04, Name: "RLC", Description: "RLC H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x05: {OpCode: 0x05, Name: "RLC", Description: "RLC L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x06: {OpCode: 0x06, Name: "RLC", Description: "RLC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x07: {OpCode: 0x07, Name: "RLC", Description: "RLC A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x08: {OpCode: 0x08, Name: "RRC", Description: "RRC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x09: {OpCode: 0x09, Name: "RRC", Description: "RRC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0a: {OpCode: 0x0a, Name: "RRC", Description: "RRC D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0b: {OpCode: 0x0b, Name: "RRC", Description: "RRC E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0c: {OpCode: 0x0c, Name: "RRC", Description: "RRC H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0d: {OpCode: 0x0d, Name: "RRC", Description: "RRC L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x0e: {OpCode: 0x0e, Name: "RRC", Description: "RRC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x0f: {OpCode: 0x0f, Name: "RRC", Description: "RRC A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x10: {OpCode: 0x10, Name: "RL", Description: "RL B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x11: {OpCode: 0x11, Name: "RL", Description: "RL C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x12: {OpCode: 0x12, Name: "RL", Description: "RL D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x13: {OpCode: 0x13, Name: "RL", Description: "RL E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x14: {OpCode: 0x14, Name: "RL", Description: "RL H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x15: {OpCode: 0x15, Name: "RL", Description: "RL L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x16: {OpCode: 0x16, Name: "RL", Description: "RL (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x17: {OpCode: 0x17, Name: "RL", Description: "RL A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x18: {OpCode: 0x18, Name: "RR", Description: "RR B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x19: {OpCode: 0x19, Name: "RR", Description: "RR C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1a: {OpCode: 0x1a, Name: "RR", Description: "RR D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1b: {OpCode: 0x1b, Name: "RR", Description: "RR E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1c: {OpCode: 0x1c, Name: "RR", Description: "RR H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1d: {OpCode: 0x1d, Name: "RR", Description: "RR L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x1e: {OpCode: 0x1e, Name: "RR", Description: "RR (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x1f: {OpCode: 0x1f, Name: "RR", Description: "RR A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x20: {OpCode: 0x20, Name: "SLA", Description: "SLA B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x21: {OpCode: 0x21, Name: "SLA", Description: "SLA C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x22: {OpCode: 0x22, Name: "SLA", Description: "SLA D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x23: {OpCode: 0x23, Name: "SLA", Description: "SLA E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x24: {OpCode: 0x24, Name: "SLA", Description: "SLA H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x25: {OpCode: 0x25, Name: "SLA", Description: "SLA L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x26: {OpCode: 0x26, Name: "SLA", Description: "SLA (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x27: {OpCode: 0x27, Name: "SLA", Description: "SLA A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x28: {OpCode: 0x28, Name: "SRA", Description: "SRA B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x29: {OpCode: 0x29, Name: "SRA", Description: "SRA C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2a: {OpCode: 0x2a, Name: "SRA", Description: "SRA D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2b: {OpCode: 0x2b, Name: "SRA", Description: "SRA E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2c: {OpCode: 0x2c, Name: "SRA", Description: "SRA H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2d: {OpCode: 0x2d, Name: "SRA", Description: "SRA L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x2e: {OpCode: 0x2e, Name: "SRA", Description: "SRA (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x2f: {OpCode: 0x2f, Name: "SRA", Description: "SRA A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x30: {OpCode: 0x30, Name: "SWAP", Description: "SWAP B", Parameters: []string{"B"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x31: {OpCode: 0x31, Name: "SWAP", Description: "SWAP C", Parameters: []string{"C"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x32: {OpCode: 0x32, Name: "SWAP", Description: "SWAP D", Parameters: []string{"D"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x33: {OpCode: 0x33, Name: "SWAP", Description: "SWAP E", Parameters: []string{"E"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x34: {OpCode: 0x34, Name: "SWAP", Description: "SWAP H", Parameters: []string{"H"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x35: {OpCode: 0x35, Name: "SWAP", Description: "SWAP L", Parameters: []string{"L"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x36: {OpCode: 0x36, Name: "SWAP", Description: "SWAP (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x37: {OpCode: 0x37, Name: "SWAP", Description: "SWAP A", Parameters: []string{"A"}, Flags: map[string]string{"C": "0", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x38: {OpCode: 0x38, Name: "SRL", Description: "SRL B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x39: {OpCode: 0x39, Name: "SRL", Description: "SRL C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3a: {OpCode: 0x3a, Name: "SRL", Description: "SRL D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3b: {OpCode: 0x3b, Name: "SRL", Description: "SRL E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3c: {OpCode: 0x3c, Name: "SRL", Description: "SRL H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3d: {OpCode: 0x3d, Name: "SRL", Description: "SRL L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x3e: {OpCode: 0x3e, Name: "SRL", Description: "SRL (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x3f: {OpCode: 0x3f, Name: "SRL", Description: "SRL A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "H": "0", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x40: {OpCode: 0x40, Name: "BIT", Description: "BIT 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x41: {OpCode: 0x41, Name: "BIT", Description: "BIT 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x42: {OpCode: 0x42, Name: "BIT", Description: "BIT 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x43: {OpCode: 0x43, Name: "BIT", Description: "BIT 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x44: {OpCode: 0x44, Name: "BIT", Description: "BIT 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x45: {OpCode: 0x45, Name: "BIT", Description: "BIT 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x46: {OpCode: 0x46, Name: "BIT", Description: "BIT 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x47: {OpCode: 0x47, Name: "BIT", Description: "BIT 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x48: {OpCode: 0x48, Name: "BIT", Description: "BIT 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x49: {OpCode: 0x49, Name: "BIT", Description: "BIT 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4a: {OpCode: 0x4a, Name: "BIT", Description: "BIT 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4b: {OpCode: 0x4b, Name: "BIT", Description: "BIT 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4c: {OpCode: 0x4c, Name: "BIT", Description: "BIT 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4d: {OpCode: 0x4d, Name: "BIT", Description: "BIT 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x4e: {OpCode: 0x4e, Name: "BIT", Description: "BIT 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x4f: {OpCode: 0x4f, Name: "BIT", Description: "BIT 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x50: {OpCode: 0x50, Name: "BIT", Description: "BIT 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x51: {OpCode: 0x51, Name: "BIT", Description: "BIT 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x52: {OpCode: 0x52, Name: "BIT", Description: "BIT 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x53: {OpCode: 0x53, Name: "BIT", Description: "BIT 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x54: {OpCode: 0x54, Name: "BIT", Description: "BIT 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x55: {OpCode: 0x55, Name: "BIT", Description: "BIT 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x56: {OpCode: 0x56, Name: "BIT", Description: "BIT 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x57: {OpCode: 0x57, Name: "BIT", Description: "BIT 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x58: {OpCode: 0x58, Name: "BIT", Description: "BIT 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x59: {OpCode: 0x59, Name: "BIT", Description: "BIT 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5a: {OpCode: 0x5a, Name: "BIT", Description: "BIT 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5b: {OpCode: 0x5b, Name: "BIT", Description: "BIT 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5c: {OpCode: 0x5c, Name: "BIT", Description: "BIT 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5d: {OpCode: 0x5d, Name: "BIT", Description: "BIT 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x5e: {OpCode: 0x5e, Name: "BIT", Description: "BIT 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x5f: {OpCode: 0x5f, Name: "BIT", Description: "BIT 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x60: {OpCode: 0x60, Name: "BIT", Description: "BIT 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x61: {OpCode: 0x61, Name: "BIT", Description: "BIT 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x62: {OpCode: 0x62, Name: "BIT", Description: "BIT 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x63: {OpCode: 0x63, Name: "BIT", Description: "BIT 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x64: {OpCode: 0x64, Name: "BIT", Description: "BIT 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x65: {OpCode: 0x65, Name: "BIT", Description: "BIT 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x66: {OpCode: 0x66, Name: "BIT", Description: "BIT 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x67: {OpCode: 0x67, Name: "BIT", Description: "BIT 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x68: {OpCode: 0x68, Name: "BIT", Description: "BIT 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x69: {OpCode: 0x69, Name: "BIT", Description: "BIT 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6a: {OpCode: 0x6a, Name: "BIT", Description: "BIT 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6b: {OpCode: 0x6b, Name: "BIT", Description: "BIT 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6c: {OpCode: 0x6c, Name: "BIT", Description: "BIT 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6d: {OpCode: 0x6d, Name: "BIT", Description: "BIT 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x6e: {OpCode: 0x6e, Name: "BIT", Description: "BIT 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x6f: {OpCode: 0x6f, Name: "BIT", Description: "BIT 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x70: {OpCode: 0x70, Name: "BIT", Description: "BIT 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x71: {OpCode: 0x71, Name: "BIT", Description: "BIT 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x72: {OpCode: 0x72, Name: "BIT", Description: "BIT 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x73: {OpCode: 0x73, Name: "BIT", Description: "BIT 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x74: {OpCode: 0x74, Name: "BIT", Description: "BIT 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x75: {OpCode: 0x75, Name: "BIT", Description: "BIT 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x76: {OpCode: 0x76, Name: "BIT", Description: "BIT 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x77: {OpCode: 0x77, Name: "BIT", Description: "BIT 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x78: {OpCode: 0x78, Name: "BIT", Description: "BIT 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x79: {OpCode: 0x79, Name: "BIT", Description: "BIT 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7a: {OpCode: 0x7a, Name: "BIT", Description: "BIT 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7b: {OpCode: 0x7b, Name: "BIT", Description: "BIT 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7c: {OpCode: 0x7c, Name: "BIT", Description: "BIT 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7d: {OpCode: 0x7d, Name: "BIT", Description: "BIT 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x7e: {OpCode: 0x7e, Name: "BIT", Description: "BIT 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
+	0x7f: {OpCode: 0x7f, Name: "BIT", Description: "BIT 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"C": "-", "H": "1", "N": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x80: {OpCode: 0x80, Name: "RES", Description: "RES 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x81: {OpCode: 0x81, Name: "RES", Description: "RES 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x82: {OpCode: 0x82, Name: "RES", Description: "RES 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x83: {OpCode: 0x83, Name: "RES", Description: "RES 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x84: {OpCode: 0x84, Name: "RES", Description: "RES 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x85: {OpCode: 0x85, Name: "RES", Description: "RES 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x86: {OpCode: 0x86, Name: "RES", Description: "RES 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x87: {OpCode: 0x87, Name: "RES", Description: "RES 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x88: {OpCode: 0x88, Name: "RES", Description: "RES 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x89: {OpCode: 0x89, Name: "RES", Description: "RES 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8a: {OpCode: 0x8a, Name: "RES", Description: "RES 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8b: {OpCode: 0x8b, Name: "RES", Description: "RES 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8c: {OpCode: 0x8c, Name: "RES", Description: "RES 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8d: {OpCode: 0x8d, Name: "RES", Description: "RES 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x8e: {OpCode: 0x8e, Name: "RES", Description: "RES 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x8f: {OpCode: 0x8f, Name: "RES", Description: "RES 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x90: {OpCode: 0x90, Name: "RES", Description: "RES 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x91: {OpCode: 0x91, Name: "RES", Description: "RES 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x92: {OpCode: 0x92, Name: "RES", Description: "RES 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x93: {OpCode: 0x93, Name: "RES", Description: "RES 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x94: {OpCode: 0x94, Name: "RES", Description: "RES 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x95: {OpCode: 0x95, Name: "RES", Description: "RES 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x96: {OpCode: 0x96, Name: "RES", Description: "RES 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x97: {OpCode: 0x97, Name: "RES", Description: "RES 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x98: {OpCode: 0x98, Name: "RES", Description: "RES 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x99: {OpCode: 0x99, Name: "RES", Description: "RES 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9a: {OpCode: 0x9a, Name: "RES", Description: "RES 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9b: {OpCode: 0x9b, Name: "RES", Description: "RES 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9c: {OpCode: 0x9c, Name: "RES", Description: "RES 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9d: {OpCode: 0x9d, Name: "RES", Description: "RES 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0x9e: {OpCode: 0x9e, Name: "RES", Description: "RES 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0x9f: {OpCode: 0x9f, Name: "RES", Description: "RES 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa0: {OpCode: 0xa0, Name: "RES", Description: "RES 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa1: {OpCode: 0xa1, Name: "RES", Description: "RES 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa2: {OpCode: 0xa2, Name: "RES", Description: "RES 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa3: {OpCode: 0xa3, Name: "RES", Description: "RES 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa4: {OpCode: 0xa4, Name: "RES", Description: "RES 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa5: {OpCode: 0xa5, Name: "RES", Description: "RES 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa6: {OpCode: 0xa6, Name: "RES", Description: "RES 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xa7: {OpCode: 0xa7, Name: "RES", Description: "RES 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa8: {OpCode: 0xa8, Name: "RES", Description: "RES 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xa9: {OpCode: 0xa9, Name: "RES", Description: "RES 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xaa: {OpCode: 0xaa, Name: "RES", Description: "RES 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xab: {OpCode: 0xab, Name: "RES", Description: "RES 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xac: {OpCode: 0xac, Name: "RES", Description: "RES 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xad: {OpCode: 0xad, Name: "RES", Description: "RES 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xae: {OpCode: 0xae, Name: "RES", Description: "RES 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xaf: {OpCode: 0xaf, Name: "RES", Description: "RES 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb0: {OpCode: 0xb0, Name: "RES", Description: "RES 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb1: {OpCode: 0xb1, Name: "RES", Description: "RES 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb2: {OpCode: 0xb2, Name: "RES", Description: "RES 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb3: {OpCode: 0xb3, Name: "RES", Description: "RES 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb4: {OpCode: 0xb4, Name: "RES", Description: "RES 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb5: {OpCode: 0xb5, Name: "RES", Description: "RES 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb6: {OpCode: 0xb6, Name: "RES", Description: "RES 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xb7: {OpCode: 0xb7, Name: "RES", Description: "RES 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb8: {OpCode: 0xb8, Name: "RES", Description: "RES 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xb9: {OpCode: 0xb9, Name: "RES", Description: "RES 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xba: {OpCode: 0xba, Name: "RES", Description: "RES 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xbb: {OpCode: 0xbb, Name: "RES", Description: "RES 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xbc: {OpCode: 0xbc, Name: "RES", Description: "RES 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xbd: {OpCode: 0xbd, Name: "RES", Description: "RES 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xbe: {OpCode: 0xbe, Name: "RES", Description: "RES 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xbf: {OpCode: 0xbf, Name: "RES", Description: "RES 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc0: {OpCode: 0xc0, Name: "SET", Description: "SET 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc1: {OpCode: 0xc1, Name: "SET", Description: "SET 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc2: {OpCode: 0xc2, Name: "SET", Description: "SET 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc3: {OpCode: 0xc3, Name: "SET", Description: "SET 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc4: {OpCode: 0xc4, Name: "SET", Description: "SET 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc5: {OpCode: 0xc5, Name: "SET", Description: "SET 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc6: {OpCode: 0xc6, Name: "SET", Description: "SET 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xc7: {OpCode: 0xc7, Name: "SET", Description: "SET 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc8: {OpCode: 0xc8, Name: "SET", Description: "SET 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xc9: {OpCode: 0xc9, Name: "SET", Description: "SET 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xca: {OpCode: 0xca, Name: "SET", Description: "SET 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xcb: {OpCode: 0xcb, Name: "SET", Description: "SET 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xcc: {OpCode: 0xcc, Name: "SET", Description: "SET 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xcd: {OpCode: 0xcd, Name: "SET", Description: "SET 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xce: {OpCode: 0xce, Name: "SET", Description: "SET 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xcf: {OpCode: 0xcf, Name: "SET", Description: "SET 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd0: {OpCode: 0xd0, Name: "SET", Description: "SET 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd1: {OpCode: 0xd1, Name: "SET", Description: "SET 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd2: {OpCode: 0xd2, Name: "SET", Description: "SET 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd3: {OpCode: 0xd3, Name: "SET", Description: "SET 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd4: {OpCode: 0xd4, Name: "SET", Description: "SET 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd5: {OpCode: 0xd5, Name: "SET", Description: "SET 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd6: {OpCode: 0xd6, Name: "SET", Description: "SET 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xd7: {OpCode: 0xd7, Name: "SET", Description: "SET 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd8: {OpCode: 0xd8, Name: "SET", Description: "SET 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xd9: {OpCode: 0xd9, Name: "SET", Description: "SET 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xda: {OpCode: 0xda, Name: "SET", Description: "SET 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xdb: {OpCode: 0xdb, Name: "SET", Description: "SET 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xdc: {OpCode: 0xdc, Name: "SET", Description: "SET 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xdd: {OpCode: 0xdd, Name: "SET", Description: "SET 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xde: {OpCode: 0xde, Name: "SET", Description: "SET 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xdf: {OpCode: 0xdf, Name: "SET", Description: "SET 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe0: {OpCode: 0xe0, Name: "SET", Description: "SET 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe1: {OpCode: 0xe1, Name: "SET", Description: "SET 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe2: {OpCode: 0xe2, Name: "SET", Description: "SET 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe3: {OpCode: 0xe3, Name: "SET", Description: "SET 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe4: {OpCode: 0xe4, Name: "SET", Description: "SET 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe5: {OpCode: 0xe5, Name: "SET", Description: "SET 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe6: {OpCode: 0xe6, Name: "SET", Description: "SET 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xe7: {OpCode: 0xe7, Name: "SET", Description: "SET 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe8: {OpCode: 0xe8, Name: "SET", Description: "SET 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xe9: {OpCode: 0xe9, Name: "SET", Description: "SET 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xea: {OpCode: 0xea, Name: "SET", Description: "SET 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xeb: {OpCode: 0xeb, Name: "SET", Description: "SET 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xec: {OpCode: 0xec, Name: "SET", Description: "SET 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xed: {OpCode: 0xed, Name: "SET", Description: "SET 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xee: {OpCode: 0xee, Name: "SET", Description: "SET 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xef: {OpCode: 0xef, Name: "SET", Description: "SET 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf0: {OpCode: 0xf0, Name: "SET", Description: "SET 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf1: {OpCode: 0xf1, Name: "SET", Description: "SET 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf2: {OpCode: 0xf2, Name: "SET", Description: "SET 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf3: {OpCode: 0xf3, Name: "SET", Description: "SET 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf4: {OpCode: 0xf4, Name: "SET", Description: "SET 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf5: {OpCode: 0xf5, Name: "SET", Description: "SET 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf6: {OpCode: 0xf6, Name: "SET", Description: "SET 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xf7: {OpCode: 0xf7, Name: "SET", Description: "SET 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf8: {OpCode: 0xf8, Name: "SET", Description: "SET 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xf9: {OpCode: 0xf9, Name: "SET", Description: "SET 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfa: {OpCode: 0xfa, Name: "SET", Description: "SET 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfb: {OpCode: 0xfb, Name: "SET", Description: "SET 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfc: {OpCode: 0xfc, Name: "SET", Description: "SET 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfd: {OpCode: 0xfd, Name: "SET", Description: "SET 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+	0xfe: {OpCode: 0xfe, Name: "SET", Description: "SET 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
+	0xff: {OpCode: 0xff, Name: "SET", Description: "SET 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"C": "-", "H": "-", "N": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+}
 
-	return instruction
+// InstructionCycles are the cycles of the instructions by opcode, when the
+// branch is taken.
+var InstructionCycles = [256]int{
+	/* 0x00 */ 4, 12, 8, 8, 4, 4, 8, 4, 20, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x10 */ 4, 12, 8, 8, 4, 4, 8, 4, 12, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x20 */ 12, 12, 8, 8, 4, 4, 8, 4, 12, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x30 */ 12, 12, 8, 8, 12, 12, 12, 4, 12, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x40 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x50 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x60 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x70 */ 8, 8, 8, 8, 8, 8, 4, 8, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x80 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x90 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xa0 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xb0 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xc0 */ 20, 12, 16, 16, 24, 16, 8, 16, 20, 16, 16, 4, 24, 24, 8, 16,
+	/* 0xd0 */ 20, 12, 16, 0, 24, 16, 8, 16, 20, 16, 16, 0, 24, 0, 8, 16,
+	/* 0xe0 */ 12, 12, 8, 0, 0, 16, 8, 16, 16, 4, 16, 0, 0, 0, 8, 16,
+	/* 0xf0 */ 12, 12, 8, 4, 0, 16, 8, 16, 12, 8, 16, 4, 0, 0, 8, 16,
 }
 
-func (c *CPU) GetPrefixedInstruction(opCode uint8) *Instruction {
-	instruction, ok := PrefixedInstructions[opCode]
-	if !ok {
-		panic(errors.New(fmt.Sprintf("cpu: invalid prefixed opcode %#02x", opCode)))
-	}
+// InstructionCyclesNoBranch are the cycles of the instructions by opcode,
+// when the branch isn't taken.
+var InstructionCyclesNoBranch = [256]int{
+	/* 0x00 */ 4, 12, 8, 8, 4, 4, 8, 4, 20, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x10 */ 4, 12, 8, 8, 4, 4, 8, 4, 12, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x20 */ 8, 12, 8, 8, 4, 4, 8, 4, 8, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x30 */ 8, 12, 8, 8, 12, 12, 12, 4, 8, 8, 8, 8, 4, 4, 8, 4,
+	/* 0x40 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x50 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x60 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x70 */ 8, 8, 8, 8, 8, 8, 4, 8, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x80 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0x90 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xa0 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xb0 */ 4, 4, 4, 4, 4, 4, 8, 4, 4, 4, 4, 4, 4, 4, 8, 4,
+	/* 0xc0 */ 8, 12, 12, 16, 12, 16, 8, 16, 8, 16, 12, 4, 12, 24, 8, 16,
+	/* 0xd0 */ 8, 12, 12, 0, 12, 16, 8, 16, 8, 16, 12, 0, 12, 0, 8, 16,
+	/* 0xe0 */ 12, 12, 8, 0, 0, 16, 8, 16, 16, 4, 16, 0, 0, 0, 8, 16,
+	/* 0xf0 */ 12, 12, 8, 4, 0, 16, 8, 16, 12, 8, 16, 4, 0, 0, 8, 16,
+}
 
-	return instruction
+// PrefixedInstructionCycles are the cycles of the prefixed instructions by
+// opcode.
+var PrefixedInstructionCycles = [256]int{
+	/* 0x00 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0x10 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0x20 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0x30 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0x40 */ 8, 8, 8, 8, 8, 8, 12, 8, 8, 8, 8, 8, 8, 8, 12, 8,
+	/* 0x50 */ 8, 8, 8, 8, 8, 8, 12, 8, 8, 8, 8, 8, 8, 8, 12, 8,
+	/* 0x60 */ 8, 8, 8, 8, 8, 8, 12, 8, 8, 8, 8, 8, 8, 8, 12, 8,
+	/* 0x70 */ 8, 8, 8, 8, 8, 8, 12, 8, 8, 8, 8, 8, 8, 8, 12, 8,
+	/* 0x80 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0x90 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xa0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xb0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xc0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xd0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xe0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
+	/* 0xf0 */ 8, 8, 8, 8, 8, 8, 16, 8, 8, 8, 8, 8, 8, 8, 16, 8,
 }
 
-var Instructions = map[uint8]*Instruction{
-	0xaf: {OpCode: 0xaf, Name: "XOR", Description: "XOR A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x57: {OpCode: 0x57, Name: "LD", Description: "LD D,A", Parameters: []string{"D", "A"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x79: {OpCode: 0x79, Name: "LD", Description: "LD A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x8c: {OpCode: 0x8c, Name: "ADC", Description: "ADC A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xba: {OpCode: 0xba, Name: "CP", Description: "CP A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x0f: {OpCode: 0x0f, Name: "RRCA", Description: "RRCA", Parameters: []string{}, Flags: map[string]string{"H": "0", "C": "C", "Z": "0", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x61: {OpCode: 0x61, Name: "LD", Description: "LD H,C", Parameters: []string{"H", "C"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb0: {OpCode: 0xb0, Name: "OR", Description: "OR A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa7: {OpCode: 0xa7, Name: "AND", Description: "AND A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xdf: {OpCode: 0xdf, Name: "RST", Description: "RST 18h", Parameters: []string{"18h"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xf4: {OpCode: 0xf4, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xe6: {OpCode: 0xe6, Name: "AND", Description: "AND A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x16: {OpCode: 0x16, Name: "LD", Description: "LD D,u8", Parameters: []string{"D", "u8"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x20: {OpCode: 0x20, Name: "JR", Description: "JR NZ,i8", Parameters: []string{"NZ", "i8"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
-	0x4d: {OpCode: 0x4d, Name: "LD", Description: "LD C,L", Parameters: []string{"C", "L"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc1: {OpCode: 0xc1, Name: "POP", Description: "POP BC", Parameters: []string{"BC"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x68: {OpCode: 0x68, Name: "LD", Description: "LD L,B", Parameters: []string{"L", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x7a: {OpCode: 0x7a, Name: "LD", Description: "LD A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x7c: {OpCode: 0x7c, Name: "LD", Description: "LD A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xff: {OpCode: 0xff, Name: "RST", Description: "RST 38h", Parameters: []string{"38h"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x44: {OpCode: 0x44, Name: "LD", Description: "LD B,H", Parameters: []string{"B", "H"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x5c: {OpCode: 0x5c, Name: "LD", Description: "LD E,H", Parameters: []string{"E", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x6d: {OpCode: 0x6d, Name: "LD", Description: "LD L,L", Parameters: []string{"L", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x86: {OpCode: 0x86, Name: "ADD", Description: "ADD A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x56: {OpCode: 0x56, Name: "LD", Description: "LD D,(HL)", Parameters: []string{"D", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7e: {OpCode: 0x7e, Name: "LD", Description: "LD A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa3: {OpCode: 0xa3, Name: "AND", Description: "AND A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x0e: {OpCode: 0x0e, Name: "LD", Description: "LD C,u8", Parameters: []string{"C", "u8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8a: {OpCode: 0x8a, Name: "ADC", Description: "ADC A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"H": "H", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb3: {OpCode: 0xb3, Name: "OR", Description: "OR A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x1d: {OpCode: 0x1d, Name: "DEC", Description: "DEC E", Parameters: []string{"E"}, Flags: map[string]string{"N": "1", "H": "H", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xce: {OpCode: 0xce, Name: "ADC", Description: "ADC A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x88: {OpCode: 0x88, Name: "ADC", Description: "ADC A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc4: {OpCode: 0xc4, Name: "CALL", Description: "CALL NZ,u16", Parameters: []string{"NZ", "u16"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
-	0xda: {OpCode: 0xda, Name: "JP", Description: "JP C,u16", Parameters: []string{"C", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
-	0x42: {OpCode: 0x42, Name: "LD", Description: "LD B,D", Parameters: []string{"B", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xec: {OpCode: 0xec, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xfc: {OpCode: 0xfc, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0x3d: {OpCode: 0x3d, Name: "DEC", Description: "DEC A", Parameters: []string{"A"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x52: {OpCode: 0x52, Name: "LD", Description: "LD D,D", Parameters: []string{"D", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x9d: {OpCode: 0x9d, Name: "SBC", Description: "SBC A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "1", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb9: {OpCode: 0xb9, Name: "CP", Description: "CP A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x00: {OpCode: 0x00, Name: "NOP", Description: "NOP", Parameters: []string{}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x47: {OpCode: 0x47, Name: "LD", Description: "LD B,A", Parameters: []string{"B", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x60: {OpCode: 0x60, Name: "LD", Description: "LD H,B", Parameters: []string{"H", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x31: {OpCode: 0x31, Name: "LD", Description: "LD SP,u16", Parameters: []string{"SP", "u16"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xc6: {OpCode: 0xc6, Name: "ADD", Description: "ADD A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xea: {OpCode: 0xea, Name: "LD", Description: "LD (u16),A", Parameters: []string{"(u16)", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x04: {OpCode: 0x04, Name: "INC", Description: "INC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x17: {OpCode: 0x17, Name: "RLA", Description: "RLA", Parameters: []string{}, Flags: map[string]string{"Z": "0", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x21: {OpCode: 0x21, Name: "LD", Description: "LD HL,u16", Parameters: []string{"HL", "u16"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xa9: {OpCode: 0xa9, Name: "XOR", Description: "XOR A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa1: {OpCode: 0xa1, Name: "AND", Description: "AND A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"N": "0", "H": "1", "C": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xcc: {OpCode: 0xcc, Name: "CALL", Description: "CALL Z,u16", Parameters: []string{"Z", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
-	0xd5: {OpCode: 0xd5, Name: "PUSH", Description: "PUSH DE", Parameters: []string{"DE"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x3c: {OpCode: 0x3c, Name: "INC", Description: "INC A", Parameters: []string{"A"}, Flags: map[string]string{"N": "0", "H": "H", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x50: {OpCode: 0x50, Name: "LD", Description: "LD D,B", Parameters: []string{"D", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x6f: {OpCode: 0x6f, Name: "LD", Description: "LD L,A", Parameters: []string{"L", "A"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x80: {OpCode: 0x80, Name: "ADD", Description: "ADD A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x8d: {OpCode: 0x8d, Name: "ADC", Description: "ADC A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd6: {OpCode: 0xd6, Name: "SUB", Description: "SUB A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xcf: {OpCode: 0xcf, Name: "RST", Description: "RST 08h", Parameters: []string{"08h"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x3a: {OpCode: 0x3a, Name: "LD", Description: "LD A,(HL-)", Parameters: []string{"A", "(HL-)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x87: {OpCode: 0x87, Name: "ADD", Description: "ADD A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa4: {OpCode: 0xa4, Name: "AND", Description: "AND A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"H": "1", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb5: {OpCode: 0xb5, Name: "OR", Description: "OR A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"H": "0", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf5: {OpCode: 0xf5, Name: "PUSH", Description: "PUSH AF", Parameters: []string{"AF"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x2e: {OpCode: 0x2e, Name: "LD", Description: "LD L,u8", Parameters: []string{"L", "u8"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x46: {OpCode: 0x46, Name: "LD", Description: "LD B,(HL)", Parameters: []string{"B", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdc: {OpCode: 0xdc, Name: "CALL", Description: "CALL C,u16", Parameters: []string{"C", "u16"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
-	0x11: {OpCode: 0x11, Name: "LD", Description: "LD DE,u16", Parameters: []string{"DE", "u16"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x29: {OpCode: 0x29, Name: "ADD", Description: "ADD HL,HL", Parameters: []string{"HL", "HL"}, Flags: map[string]string{"C": "C", "Z": "-", "N": "0", "H": "H"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x96: {OpCode: 0x96, Name: "SUB", Description: "SUB A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"N": "1", "H": "H", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdb: {OpCode: 0xdb, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0x59: {OpCode: 0x59, Name: "LD", Description: "LD E,C", Parameters: []string{"E", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x5a: {OpCode: 0x5a, Name: "LD", Description: "LD E,D", Parameters: []string{"E", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa5: {OpCode: 0xa5, Name: "AND", Description: "AND A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xee: {OpCode: 0xee, Name: "XOR", Description: "XOR A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe5: {OpCode: 0xe5, Name: "PUSH", Description: "PUSH HL", Parameters: []string{"HL"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x38: {OpCode: 0x38, Name: "JR", Description: "JR C,i8", Parameters: []string{"C", "i8"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
-	0x75: {OpCode: 0x75, Name: "LD", Description: "LD (HL),L", Parameters: []string{"(HL)", "L"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xca: {OpCode: 0xca, Name: "JP", Description: "JP Z,u16", Parameters: []string{"Z", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
-	0xe4: {OpCode: 0xe4, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0x2c: {OpCode: 0x2c, Name: "INC", Description: "INC L", Parameters: []string{"L"}, Flags: map[string]string{"H": "H", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x67: {OpCode: 0x67, Name: "LD", Description: "LD H,A", Parameters: []string{"H", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa0: {OpCode: 0xa0, Name: "AND", Description: "AND A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc0: {OpCode: 0xc0, Name: "RET", Description: "RET NZ", Parameters: []string{"NZ"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
-	0x03: {OpCode: 0x03, Name: "INC", Description: "INC BC", Parameters: []string{"BC"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x09: {OpCode: 0x09, Name: "ADD", Description: "ADD HL,BC", Parameters: []string{"HL", "BC"}, Flags: map[string]string{"Z": "-", "N": "0", "H": "H", "C": "C"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x27: {OpCode: 0x27, Name: "DAA", Description: "DAA", Parameters: []string{}, Flags: map[string]string{"N": "-", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x28: {OpCode: 0x28, Name: "JR", Description: "JR Z,i8", Parameters: []string{"Z", "i8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
-	0xc2: {OpCode: 0xc2, Name: "JP", Description: "JP NZ,u16", Parameters: []string{"NZ", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
-	0x25: {OpCode: 0x25, Name: "DEC", Description: "DEC H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x72: {OpCode: 0x72, Name: "LD", Description: "LD (HL),D", Parameters: []string{"(HL)", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3e: {OpCode: 0x3e, Name: "LD", Description: "LD A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x89: {OpCode: 0x89, Name: "ADC", Description: "ADC A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xe1: {OpCode: 0xe1, Name: "POP", Description: "POP HL", Parameters: []string{"HL"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xef: {OpCode: 0xef, Name: "RST", Description: "RST 28h", Parameters: []string{"28h"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xaa: {OpCode: 0xaa, Name: "XOR", Description: "XOR A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf2: {OpCode: 0xf2, Name: "LD", Description: "LD A,(FF00+C)", Parameters: []string{"A", "(FF00+C)"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x53: {OpCode: 0x53, Name: "LD", Description: "LD D,E", Parameters: []string{"D", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x71: {OpCode: 0x71, Name: "LD", Description: "LD (HL),C", Parameters: []string{"(HL)", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x94: {OpCode: 0x94, Name: "SUB", Description: "SUB A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x9b: {OpCode: 0x9b, Name: "SBC", Description: "SBC A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"H": "H", "C": "C", "Z": "Z", "N": "1"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd7: {OpCode: 0xd7, Name: "RST", Description: "RST 10h", Parameters: []string{"10h"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x06: {OpCode: 0x06, Name: "LD", Description: "LD B,u8", Parameters: []string{"B", "u8"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0c: {OpCode: 0x0c, Name: "INC", Description: "INC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x7f: {OpCode: 0x7f, Name: "LD", Description: "LD A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa2: {OpCode: 0xa2, Name: "AND", Description: "AND A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb1: {OpCode: 0xb1, Name: "OR", Description: "OR A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc8: {OpCode: 0xc8, Name: "RET", Description: "RET Z", Parameters: []string{"Z"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
-	0xe0: {OpCode: 0xe0, Name: "LD", Description: "LD (FF00+u8),A", Parameters: []string{"(FF00+u8)", "A"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x15: {OpCode: 0x15, Name: "DEC", Description: "DEC D", Parameters: []string{"D"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x26: {OpCode: 0x26, Name: "LD", Description: "LD H,u8", Parameters: []string{"H", "u8"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2b: {OpCode: 0x2b, Name: "DEC", Description: "DEC HL", Parameters: []string{"HL"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x66: {OpCode: 0x66, Name: "LD", Description: "LD H,(HL)", Parameters: []string{"H", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x84: {OpCode: 0x84, Name: "ADD", Description: "ADD A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf8: {OpCode: 0xf8, Name: "LD", Description: "LD HL,SP+i8", Parameters: []string{"HL", "SP+i8"}, Flags: map[string]string{"Z": "0", "N": "0", "H": "H", "C": "C"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x39: {OpCode: 0x39, Name: "ADD", Description: "ADD HL,SP", Parameters: []string{"HL", "SP"}, Flags: map[string]string{"N": "0", "H": "H", "C": "C", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4a: {OpCode: 0x4a, Name: "LD", Description: "LD C,D", Parameters: []string{"C", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x4f: {OpCode: 0x4f, Name: "LD", Description: "LD C,A", Parameters: []string{"C", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x6a: {OpCode: 0x6a, Name: "LD", Description: "LD L,D", Parameters: []string{"L", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x43: {OpCode: 0x43, Name: "LD", Description: "LD B,E", Parameters: []string{"B", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x98: {OpCode: 0x98, Name: "SBC", Description: "SBC A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "1", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x9a: {OpCode: 0x9a, Name: "SBC", Description: "SBC A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd9: {OpCode: 0xd9, Name: "RETI", Description: "RETI", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x0a: {OpCode: 0x0a, Name: "LD", Description: "LD A,(BC)", Parameters: []string{"A", "(BC)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x77: {OpCode: 0x77, Name: "LD", Description: "LD (HL),A", Parameters: []string{"(HL)", "A"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf6: {OpCode: 0xf6, Name: "OR", Description: "OR A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7d: {OpCode: 0x7d, Name: "LD", Description: "LD A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc5: {OpCode: 0xc5, Name: "PUSH", Description: "PUSH BC", Parameters: []string{"BC"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x1f: {OpCode: 0x1f, Name: "RRA", Description: "RRA", Parameters: []string{}, Flags: map[string]string{"Z": "0", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x35: {OpCode: 0x35, Name: "DEC", Description: "DEC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x64: {OpCode: 0x64, Name: "LD", Description: "LD H,H", Parameters: []string{"H", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x74: {OpCode: 0x74, Name: "LD", Description: "LD (HL),H", Parameters: []string{"(HL)", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1c: {OpCode: 0x1c, Name: "INC", Description: "INC E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x93: {OpCode: 0x93, Name: "SUB", Description: "SUB A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd4: {OpCode: 0xd4, Name: "CALL", Description: "CALL NC,u16", Parameters: []string{"NC", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 12},
-	0x19: {OpCode: 0x19, Name: "ADD", Description: "ADD HL,DE", Parameters: []string{"HL", "DE"}, Flags: map[string]string{"N": "0", "H": "H", "C": "C", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x45: {OpCode: 0x45, Name: "LD", Description: "LD B,L", Parameters: []string{"B", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x30: {OpCode: 0x30, Name: "JR", Description: "JR NC,i8", Parameters: []string{"NC", "i8"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 8},
-	0x36: {OpCode: 0x36, Name: "LD", Description: "LD (HL),u8", Parameters: []string{"(HL)", "u8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x3f: {OpCode: 0x3f, Name: "CCF", Description: "CCF", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x70: {OpCode: 0x70, Name: "LD", Description: "LD (HL),B", Parameters: []string{"(HL)", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x02: {OpCode: 0x02, Name: "LD", Description: "LD (BC),A", Parameters: []string{"(BC)", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0d: {OpCode: 0x0d, Name: "DEC", Description: "DEC C", Parameters: []string{"C"}, Flags: map[string]string{"N": "1", "H": "H", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x2a: {OpCode: 0x2a, Name: "LD", Description: "LD A,(HL+)", Parameters: []string{"A", "(HL+)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2d: {OpCode: 0x2d, Name: "DEC", Description: "DEC L", Parameters: []string{"L"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x82: {OpCode: 0x82, Name: "ADD", Description: "ADD A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x32: {OpCode: 0x32, Name: "LD", Description: "LD (HL-),A", Parameters: []string{"(HL-)", "A"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd2: {OpCode: 0xd2, Name: "JP", Description: "JP NC,u16", Parameters: []string{"NC", "u16"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 12},
-	0xdd: {OpCode: 0xdd, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xeb: {OpCode: 0xeb, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xd3: {OpCode: 0xd3, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xf3: {OpCode: 0xf3, Name: "DI", Description: "DI", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x34: {OpCode: 0x34, Name: "INC", Description: "INC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"H": "H", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x37: {OpCode: 0x37, Name: "SCF", Description: "SCF", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "0", "H": "0", "C": "1"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x73: {OpCode: 0x73, Name: "LD", Description: "LD (HL),E", Parameters: []string{"(HL)", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x95: {OpCode: 0x95, Name: "SUB", Description: "SUB A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xc9: {OpCode: 0xc9, Name: "RET", Description: "RET", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x4c: {OpCode: 0x4c, Name: "LD", Description: "LD C,H", Parameters: []string{"C", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x55: {OpCode: 0x55, Name: "LD", Description: "LD D,L", Parameters: []string{"D", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x63: {OpCode: 0x63, Name: "LD", Description: "LD H,E", Parameters: []string{"H", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x7b: {OpCode: 0x7b, Name: "LD", Description: "LD A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x07: {OpCode: 0x07, Name: "RLCA", Description: "RLCA", Parameters: []string{}, Flags: map[string]string{"Z": "0", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x48: {OpCode: 0x48, Name: "LD", Description: "LD C,B", Parameters: []string{"C", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x23: {OpCode: 0x23, Name: "INC", Description: "INC HL", Parameters: []string{"HL"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1e: {OpCode: 0x1e, Name: "LD", Description: "LD E,u8", Parameters: []string{"E", "u8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xbc: {OpCode: 0xbc, Name: "CP", Description: "CP A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xfa: {OpCode: 0xfa, Name: "LD", Description: "LD A,(u16)", Parameters: []string{"A", "(u16)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x05: {OpCode: 0x05, Name: "DEC", Description: "DEC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "1", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x4b: {OpCode: 0x4b, Name: "LD", Description: "LD C,E", Parameters: []string{"C", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x65: {OpCode: 0x65, Name: "LD", Description: "LD H,L", Parameters: []string{"H", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x99: {OpCode: 0x99, Name: "SBC", Description: "SBC A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xbb: {OpCode: 0xbb, Name: "CP", Description: "CP A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"N": "1", "H": "H", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd0: {OpCode: 0xd0, Name: "RET", Description: "RET NC", Parameters: []string{"NC"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
-	0x5b: {OpCode: 0x5b, Name: "LD", Description: "LD E,E", Parameters: []string{"E", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x5e: {OpCode: 0x5e, Name: "LD", Description: "LD E,(HL)", Parameters: []string{"E", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8f: {OpCode: 0x8f, Name: "ADC", Description: "ADC A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"H": "H", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xac: {OpCode: 0xac, Name: "XOR", Description: "XOR A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xed: {OpCode: 0xed, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0xf9: {OpCode: 0xf9, Name: "LD", Description: "LD SP,HL", Parameters: []string{"SP", "HL"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0b: {OpCode: 0x0b, Name: "DEC", Description: "DEC BC", Parameters: []string{"BC"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4e: {OpCode: 0x4e, Name: "LD", Description: "LD C,(HL)", Parameters: []string{"C", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb6: {OpCode: 0xb6, Name: "OR", Description: "OR A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"H": "0", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb8: {OpCode: 0xb8, Name: "CP", Description: "CP A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x33: {OpCode: 0x33, Name: "INC", Description: "INC SP", Parameters: []string{"SP"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5d: {OpCode: 0x5d, Name: "LD", Description: "LD E,L", Parameters: []string{"E", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa6: {OpCode: 0xa6, Name: "AND", Description: "AND A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe8: {OpCode: 0xe8, Name: "ADD", Description: "ADD SP,i8", Parameters: []string{"SP", "i8"}, Flags: map[string]string{"N": "0", "H": "H", "C": "C", "Z": "0"}, Bits: 16, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x1a: {OpCode: 0x1a, Name: "LD", Description: "LD A,(DE)", Parameters: []string{"A", "(DE)"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5f: {OpCode: 0x5f, Name: "LD", Description: "LD E,A", Parameters: []string{"E", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xbd: {OpCode: 0xbd, Name: "CP", Description: "CP A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xbf: {OpCode: 0xbf, Name: "CP", Description: "CP A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x1b: {OpCode: 0x1b, Name: "DEC", Description: "DEC DE", Parameters: []string{"DE"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xad: {OpCode: 0xad, Name: "XOR", Description: "XOR A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb4: {OpCode: 0xb4, Name: "OR", Description: "OR A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xfd: {OpCode: 0xfd, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0x18: {OpCode: 0x18, Name: "JR", Description: "JR i8", Parameters: []string{"i8"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x22: {OpCode: 0x22, Name: "LD", Description: "LD (HL+),A", Parameters: []string{"(HL+)", "A"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x58: {OpCode: 0x58, Name: "LD", Description: "LD E,B", Parameters: []string{"E", "B"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x6e: {OpCode: 0x6e, Name: "LD", Description: "LD L,(HL)", Parameters: []string{"L", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x83: {OpCode: 0x83, Name: "ADD", Description: "ADD A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x13: {OpCode: 0x13, Name: "INC", Description: "INC DE", Parameters: []string{"DE"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x76: {OpCode: 0x76, Name: "HALT", Description: "HALT", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf0: {OpCode: 0xf0, Name: "LD", Description: "LD A,(FF00+u8)", Parameters: []string{"A", "(FF00+u8)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x51: {OpCode: 0x51, Name: "LD", Description: "LD D,C", Parameters: []string{"D", "C"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x92: {OpCode: 0x92, Name: "SUB", Description: "SUB A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xcb: {OpCode: 0xcb, Name: "PREFIX", Description: "PREFIX CB", Parameters: []string{"CB"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xd8: {OpCode: 0xd8, Name: "RET", Description: "RET C", Parameters: []string{"C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 20, CyclesNoBranch: 8},
-	0xfb: {OpCode: 0xfb, Name: "EI", Description: "EI", Parameters: []string{}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xcd: {OpCode: 0xcd, Name: "CALL", Description: "CALL u16", Parameters: []string{"u16"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 24, CyclesNoBranch: 24},
-	0xe7: {OpCode: 0xe7, Name: "RST", Description: "RST 20h", Parameters: []string{"20h"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x8b: {OpCode: 0x8b, Name: "ADC", Description: "ADC A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xe9: {OpCode: 0xe9, Name: "JP", Description: "JP HL", Parameters: []string{"HL"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xfe: {OpCode: 0xfe, Name: "CP", Description: "CP A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc7: {OpCode: 0xc7, Name: "RST", Description: "RST 00h", Parameters: []string{"00h"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xde: {OpCode: 0xde, Name: "SBC", Description: "SBC A,u8", Parameters: []string{"A", "u8"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x10: {OpCode: 0x10, Name: "STOP", Description: "STOP", Parameters: []string{}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x40: {OpCode: 0x40, Name: "LD", Description: "LD B,B", Parameters: []string{"B", "B"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x54: {OpCode: 0x54, Name: "LD", Description: "LD D,H", Parameters: []string{"D", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x85: {OpCode: 0x85, Name: "ADD", Description: "ADD A,L", Parameters: []string{"A", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x3b: {OpCode: 0x3b, Name: "DEC", Description: "DEC SP", Parameters: []string{"SP"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 16, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x49: {OpCode: 0x49, Name: "LD", Description: "LD C,C", Parameters: []string{"C", "C"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x91: {OpCode: 0x91, Name: "SUB", Description: "SUB A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"H": "H", "C": "C", "Z": "Z", "N": "1"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x9e: {OpCode: 0x9e, Name: "SBC", Description: "SBC A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x01: {OpCode: 0x01, Name: "LD", Description: "LD BC,u16", Parameters: []string{"BC", "u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x08: {OpCode: 0x08, Name: "LD", Description: "LD (u16),SP", Parameters: []string{"(u16)", "SP"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 20, CyclesNoBranch: 20},
-	0x24: {OpCode: 0x24, Name: "INC", Description: "INC H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x2f: {OpCode: 0x2f, Name: "CPL", Description: "CPL", Parameters: []string{}, Flags: map[string]string{"N": "1", "H": "1", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xa8: {OpCode: 0xa8, Name: "XOR", Description: "XOR A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"C": "0", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x78: {OpCode: 0x78, Name: "LD", Description: "LD A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x90: {OpCode: 0x90, Name: "SUB", Description: "SUB A,B", Parameters: []string{"A", "B"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xab: {OpCode: 0xab, Name: "XOR", Description: "XOR A,E", Parameters: []string{"A", "E"}, Flags: map[string]string{"H": "0", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf7: {OpCode: 0xf7, Name: "RST", Description: "RST 30h", Parameters: []string{"30h"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x41: {OpCode: 0x41, Name: "LD", Description: "LD B,C", Parameters: []string{"B", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x81: {OpCode: 0x81, Name: "ADD", Description: "ADD A,C", Parameters: []string{"A", "C"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "H"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb2: {OpCode: 0xb2, Name: "OR", Description: "OR A,D", Parameters: []string{"A", "D"}, Flags: map[string]string{"H": "0", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xbe: {OpCode: 0xbe, Name: "CP", Description: "CP A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc3: {OpCode: 0xc3, Name: "JP", Description: "JP u16", Parameters: []string{"u16"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xd1: {OpCode: 0xd1, Name: "POP", Description: "POP DE", Parameters: []string{"DE"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x62: {OpCode: 0x62, Name: "LD", Description: "LD H,D", Parameters: []string{"H", "D"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x97: {OpCode: 0x97, Name: "SUB", Description: "SUB A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x9c: {OpCode: 0x9c, Name: "SBC", Description: "SBC A,H", Parameters: []string{"A", "H"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xb7: {OpCode: 0xb7, Name: "OR", Description: "OR A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xe2: {OpCode: 0xe2, Name: "LD", Description: "LD (FF00+C),A", Parameters: []string{"(FF00+C)", "A"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe3: {OpCode: 0xe3, Name: "UNUSED", Description: "UNUSED", Parameters: []string{}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 0, CyclesNoBranch: 0},
-	0x6b: {OpCode: 0x6b, Name: "LD", Description: "LD L,E", Parameters: []string{"L", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x6c: {OpCode: 0x6c, Name: "LD", Description: "LD L,H", Parameters: []string{"L", "H"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x8e: {OpCode: 0x8e, Name: "ADC", Description: "ADC A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9f: {OpCode: 0x9f, Name: "SBC", Description: "SBC A,A", Parameters: []string{"A", "A"}, Flags: map[string]string{"Z": "Z", "N": "1", "H": "H", "C": "C"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xae: {OpCode: 0xae, Name: "XOR", Description: "XOR A,(HL)", Parameters: []string{"A", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x12: {OpCode: 0x12, Name: "LD", Description: "LD (DE),A", Parameters: []string{"(DE)", "A"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x14: {OpCode: 0x14, Name: "INC", Description: "INC D", Parameters: []string{"D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "H", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0x69: {OpCode: 0x69, Name: "LD", Description: "LD L,C", Parameters: []string{"L", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 4, CyclesNoBranch: 4},
-	0xf1: {OpCode: 0xf1, Name: "POP", Description: "POP AF", Parameters: []string{"AF"}, Flags: map[string]string{"Z": "Z", "N": "N", "H": "H", "C": "C"}, Bits: 16, CyclesBranch: 12, CyclesNoBranch: 12},
+var handlers = [256]handler{
+	0x00: nopInst(Instructions[0x00]),
+	0x01: ldInst(Instructions[0x01]),
+	0x02: ldInst(Instructions[0x02]),
+	0x03: incInst(Instructions[0x03]),
+	0x04: incInst(Instructions[0x04]),
+	0x05: decInst(Instructions[0x05]),
+	0x06: ldInst(Instructions[0x06]),
+	0x07: invalidInst(Instructions[0x07]),
+	0x08: ldInst(Instructions[0x08]),
+	0x09: addInst(Instructions[0x09]),
+	0x0a: ldInst(Instructions[0x0a]),
+	0x0b: decInst(Instructions[0x0b]),
+	0x0c: incInst(Instructions[0x0c]),
+	0x0d: decInst(Instructions[0x0d]),
+	0x0e: ldInst(Instructions[0x0e]),
+	0x0f: invalidInst(Instructions[0x0f]),
+	0x10: invalidInst(Instructions[0x10]),
+	0x11: ldInst(Instructions[0x11]),
+	0x12: ldInst(Instructions[0x12]),
+	0x13: incInst(Instructions[0x13]),
+	0x14: incInst(Instructions[0x14]),
+	0x15: decInst(Instructions[0x15]),
+	0x16: ldInst(Instructions[0x16]),
+	0x17: rlaInst(Instructions[0x17]),
+	0x18: jrInst(Instructions[0x18]),
+	0x19: addInst(Instructions[0x19]),
+	0x1a: ldInst(Instructions[0x1a]),
+	0x1b: decInst(Instructions[0x1b]),
+	0x1c: incInst(Instructions[0x1c]),
+	0x1d: decInst(Instructions[0x1d]),
+	0x1e: ldInst(Instructions[0x1e]),
+	0x1f: rraInst(Instructions[0x1f]),
+	0x20: jrInst(Instructions[0x20]),
+	0x21: ldInst(Instructions[0x21]),
+	0x22: ldInst(Instructions[0x22]),
+	0x23: incInst(Instructions[0x23]),
+	0x24: incInst(Instructions[0x24]),
+	0x25: decInst(Instructions[0x25]),
+	0x26: ldInst(Instructions[0x26]),
+	0x27: invalidInst(Instructions[0x27]),
+	0x28: jrInst(Instructions[0x28]),
+	0x29: addInst(Instructions[0x29]),
+	0x2a: ldInst(Instructions[0x2a]),
+	0x2b: decInst(Instructions[0x2b]),
+	0x2c: incInst(Instructions[0x2c]),
+	0x2d: decInst(Instructions[0x2d]),
+	0x2e: ldInst(Instructions[0x2e]),
+	0x2f: cplInst(Instructions[0x2f]),
+	0x30: jrInst(Instructions[0x30]),
+	0x31: ldInst(Instructions[0x31]),
+	0x32: ldInst(Instructions[0x32]),
+	0x33: incInst(Instructions[0x33]),
+	0x34: incInst(Instructions[0x34]),
+	0x35: decInst(Instructions[0x35]),
+	0x36: ldInst(Instructions[0x36]),
+	0x37: invalidInst(Instructions[0x37]),
+	0x38: jrInst(Instructions[0x38]),
+	0x39: addInst(Instructions[0x39]),
+	0x3a: ldInst(Instructions[0x3a]),
+	0x3b: decInst(Instructions[0x3b]),
+	0x3c: incInst(Instructions[0x3c]),
+	0x3d: decInst(Instructions[0x3d]),
+	0x3e: ldInst(Instructions[0x3e]),
+	0x3f: invalidInst(Instructions[0x3f]),
+	0x40: ldInst(Instructions[0x40]),
+	0x41: ldInst(Instructions[0x41]),
+	0x42: ldInst(Instructions[0x42]),
+	0x43: ldInst(Instructions[0x43]),
+	0x44: ldInst(Instructions[0x44]),
+	0x45: ldInst(Instructions[0x45]),
+	0x46: ldInst(Instructions[0x46]),
+	0x47: ldInst(Instructions[0x47]),
+	0x48: ldInst(Instructions[0x48]),
+	0x49: ldInst(Instructions[0x49]),
+	0x4a: ldInst(Instructions[0x4a]),
+	0x4b: ldInst(Instructions[0x4b]),
+	0x4c: ldInst(Instructions[0x4c]),
+	0x4d: ldInst(Instructions[0x4d]),
+	0x4e: ldInst(Instructions[0x4e]),
+	0x4f: ldInst(Instructions[0x4f]),
+	0x50: ldInst(Instructions[0x50]),
+	0x51: ldInst(Instructions[0x51]),
+	0x52: ldInst(Instructions[0x52]),
+	0x53: ldInst(Instructions[0x53]),
+	0x54: ldInst(Instructions[0x54]),
+	0x55: ldInst(Instructions[0x55]),
+	0x56: ldInst(Instructions[0x56]),
+	0x57: ldInst(Instructions[0x57]),
+	0x58: ldInst(Instructions[0x58]),
+	0x59: ldInst(Instructions[0x59]),
+	0x5a: ldInst(Instructions[0x5a]),
+	0x5b: ldInst(Instructions[0x5b]),
+	0x5c: ldInst(Instructions[0x5c]),
+	0x5d: ldInst(Instructions[0x5d]),
+	0x5e: ldInst(Instructions[0x5e]),
+	0x5f: ldInst(Instructions[0x5f]),
+	0x60: ldInst(Instructions[0x60]),
+	0x61: ldInst(Instructions[0x61]),
+	0x62: ldInst(Instructions[0x62]),
+	0x63: ldInst(Instructions[0x63]),
+	0x64: ldInst(Instructions[0x64]),
+	0x65: ldInst(Instructions[0x65]),
+	0x66: ldInst(Instructions[0x66]),
+	0x67: ldInst(Instructions[0x67]),
+	0x68: ldInst(Instructions[0x68]),
+	0x69: ldInst(Instructions[0x69]),
+	0x6a: ldInst(Instructions[0x6a]),
+	0x6b: ldInst(Instructions[0x6b]),
+	0x6c: ldInst(Instructions[0x6c]),
+	0x6d: ldInst(Instructions[0x6d]),
+	0x6e: ldInst(Instructions[0x6e]),
+	0x6f: ldInst(Instructions[0x6f]),
+	0x70: ldInst(Instructions[0x70]),
+	0x71: ldInst(Instructions[0x71]),
+	0x72: ldInst(Instructions[0x72]),
+	0x73: ldInst(Instructions[0x73]),
+	0x74: ldInst(Instructions[0x74]),
+	0x75: ldInst(Instructions[0x75]),
+	0x76: invalidInst(Instructions[0x76]),
+	0x77: ldInst(Instructions[0x77]),
+	0x78: ldInst(Instructions[0x78]),
+	0x79: ldInst(Instructions[0x79]),
+	0x7a: ldInst(Instructions[0x7a]),
+	0x7b: ldInst(Instructions[0x7b]),
+	0x7c: ldInst(Instructions[0x7c]),
+	0x7d: ldInst(Instructions[0x7d]),
+	0x7e: ldInst(Instructions[0x7e]),
+	0x7f: ldInst(Instructions[0x7f]),
+	0x80: addInst(Instructions[0x80]),
+	0x81: addInst(Instructions[0x81]),
+	0x82: addInst(Instructions[0x82]),
+	0x83: addInst(Instructions[0x83]),
+	0x84: addInst(Instructions[0x84]),
+	0x85: addInst(Instructions[0x85]),
+	0x86: addInst(Instructions[0x86]),
+	0x87: addInst(Instructions[0x87]),
+	0x88: invalidInst(Instructions[0x88]),
+	0x89: invalidInst(Instructions[0x89]),
+	0x8a: invalidInst(Instructions[0x8a]),
+	0x8b: invalidInst(Instructions[0x8b]),
+	0x8c: invalidInst(Instructions[0x8c]),
+	0x8d: invalidInst(Instructions[0x8d]),
+	0x8e: invalidInst(Instructions[0x8e]),
+	0x8f: invalidInst(Instructions[0x8f]),
+	0x90: subInst(Instructions[0x90]),
+	0x91: subInst(Instructions[0x91]),
+	0x92: subInst(Instructions[0x92]),
+	0x93: subInst(Instructions[0x93]),
+	0x94: subInst(Instructions[0x94]),
+	0x95: subInst(Instructions[0x95]),
+	0x96: subInst(Instructions[0x96]),
+	0x97: subInst(Instructions[0x97]),
+	0x98: invalidInst(Instructions[0x98]),
+	0x99: invalidInst(Instructions[0x99]),
+	0x9a: invalidInst(Instructions[0x9a]),
+	0x9b: invalidInst(Instructions[0x9b]),
+	0x9c: invalidInst(Instructions[0x9c]),
+	0x9d: invalidInst(Instructions[0x9d]),
+	0x9e: invalidInst(Instructions[0x9e]),
+	0x9f: invalidInst(Instructions[0x9f]),
+	0xa0: andInst(Instructions[0xa0]),
+	0xa1: andInst(Instructions[0xa1]),
+	0xa2: andInst(Instructions[0xa2]),
+	0xa3: andInst(Instructions[0xa3]),
+	0xa4: andInst(Instructions[0xa4]),
+	0xa5: andInst(Instructions[0xa5]),
+	0xa6: andInst(Instructions[0xa6]),
+	0xa7: andInst(Instructions[0xa7]),
+	0xa8: xorInst(Instructions[0xa8]),
+	0xa9: xorInst(Instructions[0xa9]),
+	0xaa: xorInst(Instructions[0xaa]),
+	0xab: xorInst(Instructions[0xab]),
+	0xac: xorInst(Instructions[0xac]),
+	0xad: xorInst(Instructions[0xad]),
+	0xae: xorInst(Instructions[0xae]),
+	0xaf: xorInst(Instructions[0xaf]),
+	0xb0: orInst(Instructions[0xb0]),
+	0xb1: orInst(Instructions[0xb1]),
+	0xb2: orInst(Instructions[0xb2]),
+	0xb3: orInst(Instructions[0xb3]),
+	0xb4: orInst(Instructions[0xb4]),
+	0xb5: orInst(Instructions[0xb5]),
+	0xb6: orInst(Instructions[0xb6]),
+	0xb7: orInst(Instructions[0xb7]),
+	0xb8: cpInst(Instructions[0xb8]),
+	0xb9: cpInst(Instructions[0xb9]),
+	0xba: cpInst(Instructions[0xba]),
+	0xbb: cpInst(Instructions[0xbb]),
+	0xbc: cpInst(Instructions[0xbc]),
+	0xbd: cpInst(Instructions[0xbd]),
+	0xbe: cpInst(Instructions[0xbe]),
+	0xbf: cpInst(Instructions[0xbf]),
+	0xc0: retInst(Instructions[0xc0]),
+	0xc1: popInst(Instructions[0xc1]),
+	0xc2: jpInst(Instructions[0xc2]),
+	0xc3: jpInst(Instructions[0xc3]),
+	0xc4: callInst(Instructions[0xc4]),
+	0xc5: pushInst(Instructions[0xc5]),
+	0xc6: addInst(Instructions[0xc6]),
+	0xc7: rstInst(Instructions[0xc7]),
+	0xc8: retInst(Instructions[0xc8]),
+	0xc9: retInst(Instructions[0xc9]),
+	0xca: jpInst(Instructions[0xca]),
+	0xcb: prefixInst(Instructions[0xcb]),
+	0xcc: callInst(Instructions[0xcc]),
+	0xcd: callInst(Instructions[0xcd]),
+	0xce: invalidInst(Instructions[0xce]),
+	0xcf: rstInst(Instructions[0xcf]),
+	0xd0: retInst(Instructions[0xd0]),
+	0xd1: popInst(Instructions[0xd1]),
+	0xd2: jpInst(Instructions[0xd2]),
+	0xd3: unusedInst(Instructions[0xd3]),
+	0xd4: callInst(Instructions[0xd4]),
+	0xd5: pushInst(Instructions[0xd5]),
+	0xd6: subInst(Instructions[0xd6]),
+	0xd7: rstInst(Instructions[0xd7]),
+	0xd8: retInst(Instructions[0xd8]),
+	0xd9: invalidInst(Instructions[0xd9]),
+	0xda: jpInst(Instructions[0xda]),
+	0xdb: unusedInst(Instructions[0xdb]),
+	0xdc: callInst(Instructions[0xdc]),
+	0xdd: unusedInst(Instructions[0xdd]),
+	0xde: invalidInst(Instructions[0xde]),
+	0xdf: rstInst(Instructions[0xdf]),
+	0xe0: ldInst(Instructions[0xe0]),
+	0xe1: popInst(Instructions[0xe1]),
+	0xe2: ldInst(Instructions[0xe2]),
+	0xe3: unusedInst(Instructions[0xe3]),
+	0xe4: unusedInst(Instructions[0xe4]),
+	0xe5: pushInst(Instructions[0xe5]),
+	0xe6: andInst(Instructions[0xe6]),
+	0xe7: rstInst(Instructions[0xe7]),
+	0xe8: addInst(Instructions[0xe8]),
+	0xe9: jpInst(Instructions[0xe9]),
+	0xea: ldInst(Instructions[0xea]),
+	0xeb: unusedInst(Instructions[0xeb]),
+	0xec: unusedInst(Instructions[0xec]),
+	0xed: unusedInst(Instructions[0xed]),
+	0xee: xorInst(Instructions[0xee]),
+	0xef: rstInst(Instructions[0xef]),
+	0xf0: ldInst(Instructions[0xf0]),
+	0xf1: popInst(Instructions[0xf1]),
+	0xf2: ldInst(Instructions[0xf2]),
+	0xf3: diInst(Instructions[0xf3]),
+	0xf4: unusedInst(Instructions[0xf4]),
+	0xf5: pushInst(Instructions[0xf5]),
+	0xf6: orInst(Instructions[0xf6]),
+	0xf7: rstInst(Instructions[0xf7]),
+	0xf8: ldInst(Instructions[0xf8]),
+	0xf9: ldInst(Instructions[0xf9]),
+	0xfa: ldInst(Instructions[0xfa]),
+	0xfb: eiInst(Instructions[0xfb]),
+	0xfc: unusedInst(Instructions[0xfc]),
+	0xfd: unusedInst(Instructions[0xfd]),
+	0xfe: cpInst(Instructions[0xfe]),
+	0xff: rstInst(Instructions[0xff]),
 }
 
-var PrefixedInstructions = map[uint8]*Instruction{
-	0xb0: {OpCode: 0xb0, Name: "RES", Description: "RES 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x23: {OpCode: 0x23, Name: "SLA", Description: "SLA E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xaf: {OpCode: 0xaf, Name: "RES", Description: "RES 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6d: {OpCode: 0x6d, Name: "BIT", Description: "BIT 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xce: {OpCode: 0xce, Name: "SET", Description: "SET 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x50: {OpCode: 0x50, Name: "BIT", Description: "BIT 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa5: {OpCode: 0xa5, Name: "RES", Description: "RES 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x03: {OpCode: 0x03, Name: "RLC", Description: "RLC E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3d: {OpCode: 0x3d, Name: "SRL", Description: "SRL L", Parameters: []string{"L"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x60: {OpCode: 0x60, Name: "BIT", Description: "BIT 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa4: {OpCode: 0xa4, Name: "RES", Description: "RES 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xbf: {OpCode: 0xbf, Name: "RES", Description: "RES 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf4: {OpCode: 0xf4, Name: "SET", Description: "SET 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5a: {OpCode: 0x5a, Name: "BIT", Description: "BIT 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8a: {OpCode: 0x8a, Name: "RES", Description: "RES 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe5: {OpCode: 0xe5, Name: "SET", Description: "SET 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x19: {OpCode: 0x19, Name: "RR", Description: "RR C", Parameters: []string{"C"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2c: {OpCode: 0x2c, Name: "SRA", Description: "SRA H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc8: {OpCode: 0xc8, Name: "SET", Description: "SET 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf5: {OpCode: 0xf5, Name: "SET", Description: "SET 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2b: {OpCode: 0x2b, Name: "SRA", Description: "SRA E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3f: {OpCode: 0x3f, Name: "SRL", Description: "SRL A", Parameters: []string{"A"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x48: {OpCode: 0x48, Name: "BIT", Description: "BIT 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5c: {OpCode: 0x5c, Name: "BIT", Description: "BIT 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x10: {OpCode: 0x10, Name: "RL", Description: "RL B", Parameters: []string{"B"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x13: {OpCode: 0x13, Name: "RL", Description: "RL E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8b: {OpCode: 0x8b, Name: "RES", Description: "RES 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9e: {OpCode: 0x9e, Name: "RES", Description: "RES 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xa9: {OpCode: 0xa9, Name: "RES", Description: "RES 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb5: {OpCode: 0xb5, Name: "RES", Description: "RES 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc9: {OpCode: 0xc9, Name: "SET", Description: "SET 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd0: {OpCode: 0xd0, Name: "SET", Description: "SET 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x68: {OpCode: 0x68, Name: "BIT", Description: "BIT 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7c: {OpCode: 0x7c, Name: "BIT", Description: "BIT 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb1: {OpCode: 0xb1, Name: "RES", Description: "RES 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x09: {OpCode: 0x09, Name: "RRC", Description: "RRC C", Parameters: []string{"C"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9d: {OpCode: 0x9d, Name: "RES", Description: "RES 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8f: {OpCode: 0x8f, Name: "RES", Description: "RES 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xeb: {OpCode: 0xeb, Name: "SET", Description: "SET 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x25: {OpCode: 0x25, Name: "SLA", Description: "SLA L", Parameters: []string{"L"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5f: {OpCode: 0x5f, Name: "BIT", Description: "BIT 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2d: {OpCode: 0x2d, Name: "SRA", Description: "SRA L", Parameters: []string{"L"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x31: {OpCode: 0x31, Name: "SWAP", Description: "SWAP C", Parameters: []string{"C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x51: {OpCode: 0x51, Name: "BIT", Description: "BIT 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb7: {OpCode: 0xb7, Name: "RES", Description: "RES 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc2: {OpCode: 0xc2, Name: "SET", Description: "SET 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xee: {OpCode: 0xee, Name: "SET", Description: "SET 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x0a: {OpCode: 0x0a, Name: "RRC", Description: "RRC D", Parameters: []string{"D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x14: {OpCode: 0x14, Name: "RL", Description: "RL H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x90: {OpCode: 0x90, Name: "RES", Description: "RES 2,B", Parameters: []string{"2", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa8: {OpCode: 0xa8, Name: "RES", Description: "RES 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb4: {OpCode: 0xb4, Name: "RES", Description: "RES 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb6: {OpCode: 0xb6, Name: "RES", Description: "RES 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x22: {OpCode: 0x22, Name: "SLA", Description: "SLA D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x39: {OpCode: 0x39, Name: "SRL", Description: "SRL C", Parameters: []string{"C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x77: {OpCode: 0x77, Name: "BIT", Description: "BIT 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdf: {OpCode: 0xdf, Name: "SET", Description: "SET 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf0: {OpCode: 0xf0, Name: "SET", Description: "SET 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf3: {OpCode: 0xf3, Name: "SET", Description: "SET 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x02: {OpCode: 0x02, Name: "RLC", Description: "RLC D", Parameters: []string{"D"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1b: {OpCode: 0x1b, Name: "RR", Description: "RR E", Parameters: []string{"E"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3e: {OpCode: 0x3e, Name: "SRL", Description: "SRL (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x62: {OpCode: 0x62, Name: "BIT", Description: "BIT 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa7: {OpCode: 0xa7, Name: "RES", Description: "RES 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xca: {OpCode: 0xca, Name: "SET", Description: "SET 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x26: {OpCode: 0x26, Name: "SLA", Description: "SLA (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x29: {OpCode: 0x29, Name: "SRA", Description: "SRA C", Parameters: []string{"C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x58: {OpCode: 0x58, Name: "BIT", Description: "BIT 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x67: {OpCode: 0x67, Name: "BIT", Description: "BIT 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x82: {OpCode: 0x82, Name: "RES", Description: "RES 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd4: {OpCode: 0xd4, Name: "SET", Description: "SET 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4f: {OpCode: 0x4f, Name: "BIT", Description: "BIT 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x57: {OpCode: 0x57, Name: "BIT", Description: "BIT 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"N": "0", "H": "1", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x83: {OpCode: 0x83, Name: "RES", Description: "RES 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x06: {OpCode: 0x06, Name: "RLC", Description: "RLC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x63: {OpCode: 0x63, Name: "BIT", Description: "BIT 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x79: {OpCode: 0x79, Name: "BIT", Description: "BIT 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x81: {OpCode: 0x81, Name: "RES", Description: "RES 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9c: {OpCode: 0x9c, Name: "RES", Description: "RES 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4c: {OpCode: 0x4c, Name: "BIT", Description: "BIT 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5b: {OpCode: 0x5b, Name: "BIT", Description: "BIT 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdb: {OpCode: 0xdb, Name: "SET", Description: "SET 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x56: {OpCode: 0x56, Name: "BIT", Description: "BIT 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xbd: {OpCode: 0xbd, Name: "RES", Description: "RES 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xbb: {OpCode: 0xbb, Name: "RES", Description: "RES 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd6: {OpCode: 0xd6, Name: "SET", Description: "SET 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xe0: {OpCode: 0xe0, Name: "SET", Description: "SET 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6b: {OpCode: 0x6b, Name: "BIT", Description: "BIT 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x91: {OpCode: 0x91, Name: "RES", Description: "RES 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xcf: {OpCode: 0xcf, Name: "SET", Description: "SET 1,A", Parameters: []string{"1", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd9: {OpCode: 0xd9, Name: "SET", Description: "SET 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe4: {OpCode: 0xe4, Name: "SET", Description: "SET 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe9: {OpCode: 0xe9, Name: "SET", Description: "SET 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0b: {OpCode: 0x0b, Name: "RRC", Description: "RRC E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xaa: {OpCode: 0xaa, Name: "RES", Description: "RES 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x54: {OpCode: 0x54, Name: "BIT", Description: "BIT 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"N": "0", "H": "1", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x5e: {OpCode: 0x5e, Name: "BIT", Description: "BIT 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xa2: {OpCode: 0xa2, Name: "RES", Description: "RES 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc3: {OpCode: 0xc3, Name: "SET", Description: "SET 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf7: {OpCode: 0xf7, Name: "SET", Description: "SET 6,A", Parameters: []string{"6", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1f: {OpCode: 0x1f, Name: "RR", Description: "RR A", Parameters: []string{"A"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x21: {OpCode: 0x21, Name: "SLA", Description: "SLA C", Parameters: []string{"C"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x18: {OpCode: 0x18, Name: "RR", Description: "RR B", Parameters: []string{"B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1d: {OpCode: 0x1d, Name: "RR", Description: "RR L", Parameters: []string{"L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x27: {OpCode: 0x27, Name: "SLA", Description: "SLA A", Parameters: []string{"A"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3b: {OpCode: 0x3b, Name: "SRL", Description: "SRL E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x94: {OpCode: 0x94, Name: "RES", Description: "RES 2,H", Parameters: []string{"2", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc1: {OpCode: 0xc1, Name: "SET", Description: "SET 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0c: {OpCode: 0x0c, Name: "RRC", Description: "RRC H", Parameters: []string{"H"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0e: {OpCode: 0x0e, Name: "RRC", Description: "RRC (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xfc: {OpCode: 0xfc, Name: "SET", Description: "SET 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x44: {OpCode: 0x44, Name: "BIT", Description: "BIT 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x80: {OpCode: 0x80, Name: "RES", Description: "RES 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x95: {OpCode: 0x95, Name: "RES", Description: "RES 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb8: {OpCode: 0xb8, Name: "RES", Description: "RES 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x24: {OpCode: 0x24, Name: "SLA", Description: "SLA H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2a: {OpCode: 0x2a, Name: "SRA", Description: "SRA D", Parameters: []string{"D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4a: {OpCode: 0x4a, Name: "BIT", Description: "BIT 1,D", Parameters: []string{"1", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xb9: {OpCode: 0xb9, Name: "RES", Description: "RES 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf2: {OpCode: 0xf2, Name: "SET", Description: "SET 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x20: {OpCode: 0x20, Name: "SLA", Description: "SLA B", Parameters: []string{"B"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x33: {OpCode: 0x33, Name: "SWAP", Description: "SWAP E", Parameters: []string{"E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x47: {OpCode: 0x47, Name: "BIT", Description: "BIT 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x98: {OpCode: 0x98, Name: "RES", Description: "RES 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc7: {OpCode: 0xc7, Name: "SET", Description: "SET 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x46: {OpCode: 0x46, Name: "BIT", Description: "BIT 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"N": "0", "H": "1", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x74: {OpCode: 0x74, Name: "BIT", Description: "BIT 6,H", Parameters: []string{"6", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0f: {OpCode: 0x0f, Name: "RRC", Description: "RRC A", Parameters: []string{"A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x37: {OpCode: 0x37, Name: "SWAP", Description: "SWAP A", Parameters: []string{"A"}, Flags: map[string]string{"N": "0", "H": "0", "C": "0", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x72: {OpCode: 0x72, Name: "BIT", Description: "BIT 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe8: {OpCode: 0xe8, Name: "SET", Description: "SET 5,B", Parameters: []string{"5", "B"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2f: {OpCode: 0x2f, Name: "SRA", Description: "SRA A", Parameters: []string{"A"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc0: {OpCode: 0xc0, Name: "SET", Description: "SET 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc4: {OpCode: 0xc4, Name: "SET", Description: "SET 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xfb: {OpCode: 0xfb, Name: "SET", Description: "SET 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x45: {OpCode: 0x45, Name: "BIT", Description: "BIT 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x73: {OpCode: 0x73, Name: "BIT", Description: "BIT 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8c: {OpCode: 0x8c, Name: "RES", Description: "RES 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xcb: {OpCode: 0xcb, Name: "SET", Description: "SET 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdc: {OpCode: 0xdc, Name: "SET", Description: "SET 3,H", Parameters: []string{"3", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x28: {OpCode: 0x28, Name: "SRA", Description: "SRA B", Parameters: []string{"B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x84: {OpCode: 0x84, Name: "RES", Description: "RES 0,H", Parameters: []string{"0", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9f: {OpCode: 0x9f, Name: "RES", Description: "RES 3,A", Parameters: []string{"3", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xef: {OpCode: 0xef, Name: "SET", Description: "SET 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x85: {OpCode: 0x85, Name: "RES", Description: "RES 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x38: {OpCode: 0x38, Name: "SRL", Description: "SRL B", Parameters: []string{"B"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x49: {OpCode: 0x49, Name: "BIT", Description: "BIT 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7d: {OpCode: 0x7d, Name: "BIT", Description: "BIT 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc6: {OpCode: 0xc6, Name: "SET", Description: "SET 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xf6: {OpCode: 0xf6, Name: "SET", Description: "SET 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x32: {OpCode: 0x32, Name: "SWAP", Description: "SWAP D", Parameters: []string{"D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x35: {OpCode: 0x35, Name: "SWAP", Description: "SWAP L", Parameters: []string{"L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xec: {OpCode: 0xec, Name: "SET", Description: "SET 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x69: {OpCode: 0x69, Name: "BIT", Description: "BIT 5,C", Parameters: []string{"5", "C"}, Flags: map[string]string{"N": "0", "H": "1", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6a: {OpCode: 0x6a, Name: "BIT", Description: "BIT 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x55: {OpCode: 0x55, Name: "BIT", Description: "BIT 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x64: {OpCode: 0x64, Name: "BIT", Description: "BIT 4,H", Parameters: []string{"4", "H"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd8: {OpCode: 0xd8, Name: "SET", Description: "SET 3,B", Parameters: []string{"3", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x01: {OpCode: 0x01, Name: "RLC", Description: "RLC C", Parameters: []string{"C"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x41: {OpCode: 0x41, Name: "BIT", Description: "BIT 0,C", Parameters: []string{"0", "C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1c: {OpCode: 0x1c, Name: "RR", Description: "RR H", Parameters: []string{"H"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x70: {OpCode: 0x70, Name: "BIT", Description: "BIT 6,B", Parameters: []string{"6", "B"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x71: {OpCode: 0x71, Name: "BIT", Description: "BIT 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa1: {OpCode: 0xa1, Name: "RES", Description: "RES 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe2: {OpCode: 0xe2, Name: "SET", Description: "SET 4,D", Parameters: []string{"4", "D"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x40: {OpCode: 0x40, Name: "BIT", Description: "BIT 0,B", Parameters: []string{"0", "B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x66: {OpCode: 0x66, Name: "BIT", Description: "BIT 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x43: {OpCode: 0x43, Name: "BIT", Description: "BIT 0,E", Parameters: []string{"0", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7b: {OpCode: 0x7b, Name: "BIT", Description: "BIT 7,E", Parameters: []string{"7", "E"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xde: {OpCode: 0xde, Name: "SET", Description: "SET 3,(HL)", Parameters: []string{"3", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x36: {OpCode: 0x36, Name: "SWAP", Description: "SWAP (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"H": "0", "C": "0", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xb3: {OpCode: 0xb3, Name: "RES", Description: "RES 6,E", Parameters: []string{"6", "E"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa0: {OpCode: 0xa0, Name: "RES", Description: "RES 4,B", Parameters: []string{"4", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa6: {OpCode: 0xa6, Name: "RES", Description: "RES 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xd2: {OpCode: 0xd2, Name: "SET", Description: "SET 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf1: {OpCode: 0xf1, Name: "SET", Description: "SET 6,C", Parameters: []string{"6", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x89: {OpCode: 0x89, Name: "RES", Description: "RES 1,C", Parameters: []string{"1", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8e: {OpCode: 0x8e, Name: "RES", Description: "RES 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xf8: {OpCode: 0xf8, Name: "SET", Description: "SET 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x97: {OpCode: 0x97, Name: "RES", Description: "RES 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x9b: {OpCode: 0x9b, Name: "RES", Description: "RES 3,E", Parameters: []string{"3", "E"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x78: {OpCode: 0x78, Name: "BIT", Description: "BIT 7,B", Parameters: []string{"7", "B"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd5: {OpCode: 0xd5, Name: "SET", Description: "SET 2,L", Parameters: []string{"2", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3a: {OpCode: 0x3a, Name: "SRL", Description: "SRL D", Parameters: []string{"D"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7f: {OpCode: 0x7f, Name: "BIT", Description: "BIT 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x86: {OpCode: 0x86, Name: "RES", Description: "RES 0,(HL)", Parameters: []string{"0", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x9a: {OpCode: 0x9a, Name: "RES", Description: "RES 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xa3: {OpCode: 0xa3, Name: "RES", Description: "RES 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xf9: {OpCode: 0xf9, Name: "SET", Description: "SET 7,C", Parameters: []string{"7", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x05: {OpCode: 0x05, Name: "RLC", Description: "RLC L", Parameters: []string{"L"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x34: {OpCode: 0x34, Name: "SWAP", Description: "SWAP H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x2e: {OpCode: 0x2e, Name: "SRA", Description: "SRA (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x61: {OpCode: 0x61, Name: "BIT", Description: "BIT 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"H": "1", "C": "-", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xcc: {OpCode: 0xcc, Name: "SET", Description: "SET 1,H", Parameters: []string{"1", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xfe: {OpCode: 0xfe, Name: "SET", Description: "SET 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x12: {OpCode: 0x12, Name: "RL", Description: "RL D", Parameters: []string{"D"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6f: {OpCode: 0x6f, Name: "BIT", Description: "BIT 5,A", Parameters: []string{"5", "A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x0d: {OpCode: 0x0d, Name: "RRC", Description: "RRC L", Parameters: []string{"L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x52: {OpCode: 0x52, Name: "BIT", Description: "BIT 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4b: {OpCode: 0x4b, Name: "BIT", Description: "BIT 1,E", Parameters: []string{"1", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x53: {OpCode: 0x53, Name: "BIT", Description: "BIT 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x59: {OpCode: 0x59, Name: "BIT", Description: "BIT 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x93: {OpCode: 0x93, Name: "RES", Description: "RES 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xbe: {OpCode: 0xbe, Name: "RES", Description: "RES 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xe3: {OpCode: 0xe3, Name: "SET", Description: "SET 4,E", Parameters: []string{"4", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x00: {OpCode: 0x00, Name: "RLC", Description: "RLC B", Parameters: []string{"B"}, Flags: map[string]string{"C": "C", "Z": "Z", "N": "0", "H": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x16: {OpCode: 0x16, Name: "RL", Description: "RL (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xba: {OpCode: 0xba, Name: "RES", Description: "RES 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x42: {OpCode: 0x42, Name: "BIT", Description: "BIT 0,D", Parameters: []string{"0", "D"}, Flags: map[string]string{"N": "0", "H": "1", "C": "-", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6e: {OpCode: 0x6e, Name: "BIT", Description: "BIT 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x1a: {OpCode: 0x1a, Name: "RR", Description: "RR D", Parameters: []string{"D"}, Flags: map[string]string{"H": "0", "C": "C", "Z": "Z", "N": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x88: {OpCode: 0x88, Name: "RES", Description: "RES 1,B", Parameters: []string{"1", "B"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x92: {OpCode: 0x92, Name: "RES", Description: "RES 2,D", Parameters: []string{"2", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd3: {OpCode: 0xd3, Name: "SET", Description: "SET 2,E", Parameters: []string{"2", "E"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xdd: {OpCode: 0xdd, Name: "SET", Description: "SET 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xea: {OpCode: 0xea, Name: "SET", Description: "SET 5,D", Parameters: []string{"5", "D"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x07: {OpCode: 0x07, Name: "RLC", Description: "RLC A", Parameters: []string{"A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x17: {OpCode: 0x17, Name: "RL", Description: "RL A", Parameters: []string{"A"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xcd: {OpCode: 0xcd, Name: "SET", Description: "SET 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x11: {OpCode: 0x11, Name: "RL", Description: "RL C", Parameters: []string{"C"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x96: {OpCode: 0x96, Name: "RES", Description: "RES 2,(HL)", Parameters: []string{"2", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x30: {OpCode: 0x30, Name: "SWAP", Description: "SWAP B", Parameters: []string{"B"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "0"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xae: {OpCode: 0xae, Name: "RES", Description: "RES 5,(HL)", Parameters: []string{"5", "(HL)"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xbc: {OpCode: 0xbc, Name: "RES", Description: "RES 7,H", Parameters: []string{"7", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xc5: {OpCode: 0xc5, Name: "SET", Description: "SET 0,L", Parameters: []string{"0", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x04: {OpCode: 0x04, Name: "RLC", Description: "RLC H", Parameters: []string{"H"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x1e: {OpCode: 0x1e, Name: "RR", Description: "RR (HL)", Parameters: []string{"(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0x5d: {OpCode: 0x5d, Name: "BIT", Description: "BIT 3,L", Parameters: []string{"3", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xda: {OpCode: 0xda, Name: "SET", Description: "SET 3,D", Parameters: []string{"3", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x08: {OpCode: 0x08, Name: "RRC", Description: "RRC B", Parameters: []string{"B"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4e: {OpCode: 0x4e, Name: "BIT", Description: "BIT 1,(HL)", Parameters: []string{"1", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "Z", "N": "0", "H": "1"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xed: {OpCode: 0xed, Name: "SET", Description: "SET 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe1: {OpCode: 0xe1, Name: "SET", Description: "SET 4,C", Parameters: []string{"4", "C"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x75: {OpCode: 0x75, Name: "BIT", Description: "BIT 6,L", Parameters: []string{"6", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x99: {OpCode: 0x99, Name: "RES", Description: "RES 3,C", Parameters: []string{"3", "C"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe7: {OpCode: 0xe7, Name: "SET", Description: "SET 4,A", Parameters: []string{"4", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x6c: {OpCode: 0x6c, Name: "BIT", Description: "BIT 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd1: {OpCode: 0xd1, Name: "SET", Description: "SET 2,C", Parameters: []string{"2", "C"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x87: {OpCode: 0x87, Name: "RES", Description: "RES 0,A", Parameters: []string{"0", "A"}, Flags: map[string]string{"N": "-", "H": "-", "C": "-", "Z": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xad: {OpCode: 0xad, Name: "RES", Description: "RES 5,L", Parameters: []string{"5", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x15: {OpCode: 0x15, Name: "RL", Description: "RL L", Parameters: []string{"L"}, Flags: map[string]string{"N": "0", "H": "0", "C": "C", "Z": "Z"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x65: {OpCode: 0x65, Name: "BIT", Description: "BIT 4,L", Parameters: []string{"4", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xe6: {OpCode: 0xe6, Name: "SET", Description: "SET 4,(HL)", Parameters: []string{"4", "(HL)"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 16, CyclesNoBranch: 16},
-	0xfa: {OpCode: 0xfa, Name: "SET", Description: "SET 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x7e: {OpCode: 0x7e, Name: "BIT", Description: "BIT 7,(HL)", Parameters: []string{"7", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0xb2: {OpCode: 0xb2, Name: "RES", Description: "RES 6,D", Parameters: []string{"6", "D"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x76: {OpCode: 0x76, Name: "BIT", Description: "BIT 6,(HL)", Parameters: []string{"6", "(HL)"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 12, CyclesNoBranch: 12},
-	0x7a: {OpCode: 0x7a, Name: "BIT", Description: "BIT 7,D", Parameters: []string{"7", "D"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x8d: {OpCode: 0x8d, Name: "RES", Description: "RES 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"H": "-", "C": "-", "Z": "-", "N": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xab: {OpCode: 0xab, Name: "RES", Description: "RES 5,E", Parameters: []string{"5", "E"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xac: {OpCode: 0xac, Name: "RES", Description: "RES 5,H", Parameters: []string{"5", "H"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xd7: {OpCode: 0xd7, Name: "SET", Description: "SET 2,A", Parameters: []string{"2", "A"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x3c: {OpCode: 0x3c, Name: "SRL", Description: "SRL H", Parameters: []string{"H"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "0", "C": "C"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0x4d: {OpCode: 0x4d, Name: "BIT", Description: "BIT 1,L", Parameters: []string{"1", "L"}, Flags: map[string]string{"Z": "Z", "N": "0", "H": "1", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xfd: {OpCode: 0xfd, Name: "SET", Description: "SET 7,L", Parameters: []string{"7", "L"}, Flags: map[string]string{"Z": "-", "N": "-", "H": "-", "C": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
-	0xff: {OpCode: 0xff, Name: "SET", Description: "SET 7,A", Parameters: []string{"7", "A"}, Flags: map[string]string{"C": "-", "Z": "-", "N": "-", "H": "-"}, Bits: 8, CyclesBranch: 8, CyclesNoBranch: 8},
+var prefixedHandlers = [256]handler{
+	0x00: invalidInst(PrefixedInstructions[0x00]),
+	0x01: invalidInst(PrefixedInstructions[0x01]),
+	0x02: invalidInst(PrefixedInstructions[0x02]),
+	0x03: invalidInst(PrefixedInstructions[0x03]),
+	0x04: invalidInst(PrefixedInstructions[0x04]),
+	0x05: invalidInst(PrefixedInstructions[0x05]),
+	0x06: invalidInst(PrefixedInstructions[0x06]),
+	0x07: invalidInst(PrefixedInstructions[0x07]),
+	0x08: invalidInst(PrefixedInstructions[0x08]),
+	0x09: invalidInst(PrefixedInstructions[0x09]),
+	0x0a: invalidInst(PrefixedInstructions[0x0a]),
+	0x0b: invalidInst(PrefixedInstructions[0x0b]),
+	0x0c: invalidInst(PrefixedInstructions[0x0c]),
+	0x0d: invalidInst(PrefixedInstructions[0x0d]),
+	0x0e: invalidInst(PrefixedInstructions[0x0e]),
+	0x0f: invalidInst(PrefixedInstructions[0x0f]),
+	0x10: rlInst(PrefixedInstructions[0x10]),
+	0x11: rlInst(PrefixedInstructions[0x11]),
+	0x12: rlInst(PrefixedInstructions[0x12]),
+	0x13: rlInst(PrefixedInstructions[0x13]),
+	0x14: rlInst(PrefixedInstructions[0x14]),
+	0x15: rlInst(PrefixedInstructions[0x15]),
+	0x16: rlInst(PrefixedInstructions[0x16]),
+	0x17: rlInst(PrefixedInstructions[0x17]),
+	0x18: rrInst(PrefixedInstructions[0x18]),
+	0x19: rrInst(PrefixedInstructions[0x19]),
+	0x1a: rrInst(PrefixedInstructions[0x1a]),
+	0x1b: rrInst(PrefixedInstructions[0x1b]),
+	0x1c: rrInst(PrefixedInstructions[0x1c]),
+	0x1d: rrInst(PrefixedInstructions[0x1d]),
+	0x1e: rrInst(PrefixedInstructions[0x1e]),
+	0x1f: rrInst(PrefixedInstructions[0x1f]),
+	0x20: invalidInst(PrefixedInstructions[0x20]),
+	0x21: invalidInst(PrefixedInstructions[0x21]),
+	0x22: invalidInst(PrefixedInstructions[0x22]),
+	0x23: invalidInst(PrefixedInstructions[0x23]),
+	0x24: invalidInst(PrefixedInstructions[0x24]),
+	0x25: invalidInst(PrefixedInstructions[0x25]),
+	0x26: invalidInst(PrefixedInstructions[0x26]),
+	0x27: invalidInst(PrefixedInstructions[0x27]),
+	0x28: invalidInst(PrefixedInstructions[0x28]),
+	0x29: invalidInst(PrefixedInstructions[0x29]),
+	0x2a: invalidInst(PrefixedInstructions[0x2a]),
+	0x2b: invalidInst(PrefixedInstructions[0x2b]),
+	0x2c: invalidInst(PrefixedInstructions[0x2c]),
+	0x2d: invalidInst(PrefixedInstructions[0x2d]),
+	0x2e: invalidInst(PrefixedInstructions[0x2e]),
+	0x2f: invalidInst(PrefixedInstructions[0x2f]),
+	0x30: swapInst(PrefixedInstructions[0x30]),
+	0x31: swapInst(PrefixedInstructions[0x31]),
+	0x32: swapInst(PrefixedInstructions[0x32]),
+	0x33: swapInst(PrefixedInstructions[0x33]),
+	0x34: swapInst(PrefixedInstructions[0x34]),
+	0x35: swapInst(PrefixedInstructions[0x35]),
+	0x36: swapInst(PrefixedInstructions[0x36]),
+	0x37: swapInst(PrefixedInstructions[0x37]),
+	0x38: srlInst(PrefixedInstructions[0x38]),
+	0x39: srlInst(PrefixedInstructions[0x39]),
+	0x3a: srlInst(PrefixedInstructions[0x3a]),
+	0x3b: srlInst(PrefixedInstructions[0x3b]),
+	0x3c: srlInst(PrefixedInstructions[0x3c]),
+	0x3d: srlInst(PrefixedInstructions[0x3d]),
+	0x3e: srlInst(PrefixedInstructions[0x3e]),
+	0x3f: srlInst(PrefixedInstructions[0x3f]),
+	0x40: bitInst(PrefixedInstructions[0x40]),
+	0x41: bitInst(PrefixedInstructions[0x41]),
+	0x42: bitInst(PrefixedInstructions[0x42]),
+	0x43: bitInst(PrefixedInstructions[0x43]),
+	0x44: bitInst(PrefixedInstructions[0x44]),
+	0x45: bitInst(PrefixedInstructions[0x45]),
+	0x46: bitInst(PrefixedInstructions[0x46]),
+	0x47: bitInst(PrefixedInstructions[0x47]),
+	0x48: bitInst(PrefixedInstructions[0x48]),
+	0x49: bitInst(PrefixedInstructions[0x49]),
+	0x4a: bitInst(PrefixedInstructions[0x4a]),
+	0x4b: bitInst(PrefixedInstructions[0x4b]),
+	0x4c: bitInst(PrefixedInstructions[0x4c]),
+	0x4d: bitInst(PrefixedInstructions[0x4d]),
+	0x4e: bitInst(PrefixedInstructions[0x4e]),
+	0x4f: bitInst(PrefixedInstructions[0x4f]),
+	0x50: bitInst(PrefixedInstructions[0x50]),
+	0x51: bitInst(PrefixedInstructions[0x51]),
+	0x52: bitInst(PrefixedInstructions[0x52]),
+	0x53: bitInst(PrefixedInstructions[0x53]),
+	0x54: bitInst(PrefixedInstructions[0x54]),
+	0x55: bitInst(PrefixedInstructions[0x55]),
+	0x56: bitInst(PrefixedInstructions[0x56]),
+	0x57: bitInst(PrefixedInstructions[0x57]),
+	0x58: bitInst(PrefixedInstructions[0x58]),
+	0x59: bitInst(PrefixedInstructions[0x59]),
+	0x5a: bitInst(PrefixedInstructions[0x5a]),
+	0x5b: bitInst(PrefixedInstructions[0x5b]),
+	0x5c: bitInst(PrefixedInstructions[0x5c]),
+	0x5d: bitInst(PrefixedInstructions[0x5d]),
+	0x5e: bitInst(PrefixedInstructions[0x5e]),
+	0x5f: bitInst(PrefixedInstructions[0x5f]),
+	0x60: bitInst(PrefixedInstructions[0x60]),
+	0x61: bitInst(PrefixedInstructions[0x61]),
+	0x62: bitInst(PrefixedInstructions[0x62]),
+	0x63: bitInst(PrefixedInstructions[0x63]),
+	0x64: bitInst(PrefixedInstructions[0x64]),
+	0x65: bitInst(PrefixedInstructions[0x65]),
+	0x66: bitInst(PrefixedInstructions[0x66]),
+	0x67: bitInst(PrefixedInstructions[0x67]),
+	0x68: bitInst(PrefixedInstructions[0x68]),
+	0x69: bitInst(PrefixedInstructions[0x69]),
+	0x6a: bitInst(PrefixedInstructions[0x6a]),
+	0x6b: bitInst(PrefixedInstructions[0x6b]),
+	0x6c: bitInst(PrefixedInstructions[0x6c]),
+	0x6d: bitInst(PrefixedInstructions[0x6d]),
+	0x6e: bitInst(PrefixedInstructions[0x6e]),
+	0x6f: bitInst(PrefixedInstructions[0x6f]),
+	0x70: bitInst(PrefixedInstructions[0x70]),
+	0x71: bitInst(PrefixedInstructions[0x71]),
+	0x72: bitInst(PrefixedInstructions[0x72]),
+	0x73: bitInst(PrefixedInstructions[0x73]),
+	0x74: bitInst(PrefixedInstructions[0x74]),
+	0x75: bitInst(PrefixedInstructions[0x75]),
+	0x76: bitInst(PrefixedInstructions[0x76]),
+	0x77: bitInst(PrefixedInstructions[0x77]),
+	0x78: bitInst(PrefixedInstructions[0x78]),
+	0x79: bitInst(PrefixedInstructions[0x79]),
+	0x7a: bitInst(PrefixedInstructions[0x7a]),
+	0x7b: bitInst(PrefixedInstructions[0x7b]),
+	0x7c: bitInst(PrefixedInstructions[0x7c]),
+	0x7d: bitInst(PrefixedInstructions[0x7d]),
+	0x7e: bitInst(PrefixedInstructions[0x7e]),
+	0x7f: bitInst(PrefixedInstructions[0x7f]),
+	0x80: invalidInst(PrefixedInstructions[0x80]),
+	0x81: invalidInst(PrefixedInstructions[0x81]),
+	0x82: invalidInst(PrefixedInstructions[0x82]),
+	0x83: invalidInst(PrefixedInstructions[0x83]),
+	0x84: invalidInst(PrefixedInstructions[0x84]),
+	0x85: invalidInst(PrefixedInstructions[0x85]),
+	0x86: invalidInst(PrefixedInstructions[0x86]),
+	0x87: invalidInst(PrefixedInstructions[0x87]),
+	0x88: invalidInst(PrefixedInstructions[0x88]),
+	0x89: invalidInst(PrefixedInstructions[0x89]),
+	0x8a: invalidInst(PrefixedInstructions[0x8a]),
+	0x8b: invalidInst(PrefixedInstructions[0x8b]),
+	0x8c: invalidInst(PrefixedInstructions[0x8c]),
+	0x8d: invalidInst(PrefixedInstructions[0x8d]),
+	0x8e: invalidInst(PrefixedInstructions[0x8e]),
+	0x8f: invalidInst(PrefixedInstructions[0x8f]),
+	0x90: invalidInst(PrefixedInstructions[0x90]),
+	0x91: invalidInst(PrefixedInstructions[0x91]),
+	0x92: invalidInst(PrefixedInstructions[0x92]),
+	0x93: invalidInst(PrefixedInstructions[0x93]),
+	0x94: invalidInst(PrefixedInstructions[0x94]),
+	0x95: invalidInst(PrefixedInstructions[0x95]),
+	0x96: invalidInst(PrefixedInstructions[0x96]),
+	0x97: invalidInst(PrefixedInstructions[0x97]),
+	0x98: invalidInst(PrefixedInstructions[0x98]),
+	0x99: invalidInst(PrefixedInstructions[0x99]),
+	0x9a: invalidInst(PrefixedInstructions[0x9a]),
+	0x9b: invalidInst(PrefixedInstructions[0x9b]),
+	0x9c: invalidInst(PrefixedInstructions[0x9c]),
+	0x9d: invalidInst(PrefixedInstructions[0x9d]),
+	0x9e: invalidInst(PrefixedInstructions[0x9e]),
+	0x9f: invalidInst(PrefixedInstructions[0x9f]),
+	0xa0: invalidInst(PrefixedInstructions[0xa0]),
+	0xa1: invalidInst(PrefixedInstructions[0xa1]),
+	0xa2: invalidInst(PrefixedInstructions[0xa2]),
+	0xa3: invalidInst(PrefixedInstructions[0xa3]),
+	0xa4: invalidInst(PrefixedInstructions[0xa4]),
+	0xa5: invalidInst(PrefixedInstructions[0xa5]),
+	0xa6: invalidInst(PrefixedInstructions[0xa6]),
+	0xa7: invalidInst(PrefixedInstructions[0xa7]),
+	0xa8: invalidInst(PrefixedInstructions[0xa8]),
+	0xa9: invalidInst(PrefixedInstructions[0xa9]),
+	0xaa: invalidInst(PrefixedInstructions[0xaa]),
+	0xab: invalidInst(PrefixedInstructions[0xab]),
+	0xac: invalidInst(PrefixedInstructions[0xac]),
+	0xad: invalidInst(PrefixedInstructions[0xad]),
+	0xae: invalidInst(PrefixedInstructions[0xae]),
+	0xaf: invalidInst(PrefixedInstructions[0xaf]),
+	0xb0: invalidInst(PrefixedInstructions[0xb0]),
+	0xb1: invalidInst(PrefixedInstructions[0xb1]),
+	0xb2: invalidInst(PrefixedInstructions[0xb2]),
+	0xb3: invalidInst(PrefixedInstructions[0xb3]),
+	0xb4: invalidInst(PrefixedInstructions[0xb4]),
+	0xb5: invalidInst(PrefixedInstructions[0xb5]),
+	0xb6: invalidInst(PrefixedInstructions[0xb6]),
+	0xb7: invalidInst(PrefixedInstructions[0xb7]),
+	0xb8: invalidInst(PrefixedInstructions[0xb8]),
+	0xb9: invalidInst(PrefixedInstructions[0xb9]),
+	0xba: invalidInst(PrefixedInstructions[0xba]),
+	0xbb: invalidInst(PrefixedInstructions[0xbb]),
+	0xbc: invalidInst(PrefixedInstructions[0xbc]),
+	0xbd: invalidInst(PrefixedInstructions[0xbd]),
+	0xbe: invalidInst(PrefixedInstructions[0xbe]),
+	0xbf: invalidInst(PrefixedInstructions[0xbf]),
+	0xc0: invalidInst(PrefixedInstructions[0xc0]),
+	0xc1: invalidInst(PrefixedInstructions[0xc1]),
+	0xc2: invalidInst(PrefixedInstructions[0xc2]),
+	0xc3: invalidInst(PrefixedInstructions[0xc3]),
+	0xc4: invalidInst(PrefixedInstructions[0xc4]),
+	0xc5: invalidInst(PrefixedInstructions[0xc5]),
+	0xc6: invalidInst(PrefixedInstructions[0xc6]),
+	0xc7: invalidInst(PrefixedInstructions[0xc7]),
+	0xc8: invalidInst(PrefixedInstructions[0xc8]),
+	0xc9: invalidInst(PrefixedInstructions[0xc9]),
+	0xca: invalidInst(PrefixedInstructions[0xca]),
+	0xcb: invalidInst(PrefixedInstructions[0xcb]),
+	0xcc: invalidInst(PrefixedInstructions[0xcc]),
+	0xcd: invalidInst(PrefixedInstructions[0xcd]),
+	0xce: invalidInst(PrefixedInstructions[0xce]),
+	0xcf: invalidInst(PrefixedInstructions[0xcf]),
+	0xd0: invalidInst(PrefixedInstructions[0xd0]),
+	0xd1: invalidInst(PrefixedInstructions[0xd1]),
+	0xd2: invalidInst(PrefixedInstructions[0xd2]),
+	0xd3: invalidInst(PrefixedInstructions[0xd3]),
+	0xd4: invalidInst(PrefixedInstructions[0xd4]),
+	0xd5: invalidInst(PrefixedInstructions[0xd5]),
+	0xd6: invalidInst(PrefixedInstructions[0xd6]),
+	0xd7: invalidInst(PrefixedInstructions[0xd7]),
+	0xd8: invalidInst(PrefixedInstructions[0xd8]),
+	0xd9: invalidInst(PrefixedInstructions[0xd9]),
+	0xda: invalidInst(PrefixedInstructions[0xda]),
+	0xdb: invalidInst(PrefixedInstructions[0xdb]),
+	0xdc: invalidInst(PrefixedInstructions[0xdc]),
+	0xdd: invalidInst(PrefixedInstructions[0xdd]),
+	0xde: invalidInst(PrefixedInstructions[0xde]),
+	0xdf: invalidInst(PrefixedInstructions[0xdf]),
+	0xe0: invalidInst(PrefixedInstructions[0xe0]),
+	0xe1: invalidInst(PrefixedInstructions[0xe1]),
+	0xe2: invalidInst(PrefixedInstructions[0xe2]),
+	0xe3: invalidInst(PrefixedInstructions[0xe3]),
+	0xe4: invalidInst(PrefixedInstructions[0xe4]),
+	0xe5: invalidInst(PrefixedInstructions[0xe5]),
+	0xe6: invalidInst(PrefixedInstructions[0xe6]),
+	0xe7: invalidInst(PrefixedInstructions[0xe7]),
+	0xe8: invalidInst(PrefixedInstructions[0xe8]),
+	0xe9: invalidInst(PrefixedInstructions[0xe9]),
+	0xea: invalidInst(PrefixedInstructions[0xea]),
+	0xeb: invalidInst(PrefixedInstructions[0xeb]),
+	0xec: invalidInst(PrefixedInstructions[0xec]),
+	0xed: invalidInst(PrefixedInstructions[0xed]),
+	0xee: invalidInst(PrefixedInstructions[0xee]),
+	0xef: invalidInst(PrefixedInstructions[0xef]),
+	0xf0: invalidInst(PrefixedInstructions[0xf0]),
+	0xf1: invalidInst(PrefixedInstructions[0xf1]),
+	0xf2: invalidInst(PrefixedInstructions[0xf2]),
+	0xf3: invalidInst(PrefixedInstructions[0xf3]),
+	0xf4: invalidInst(PrefixedInstructions[0xf4]),
+	0xf5: invalidInst(PrefixedInstructions[0xf5]),
+	0xf6: invalidInst(PrefixedInstructions[0xf6]),
+	0xf7: invalidInst(PrefixedInstructions[0xf7]),
+	0xf8: invalidInst(PrefixedInstructions[0xf8]),
+	0xf9: invalidInst(PrefixedInstructions[0xf9]),
+	0xfa: invalidInst(PrefixedInstructions[0xfa]),
+	0xfb: invalidInst(PrefixedInstructions[0xfb]),
+	0xfc: invalidInst(PrefixedInstructions[0xfc]),
+	0xfd: invalidInst(PrefixedInstructions[0xfd]),
+	0xfe: invalidInst(PrefixedInstructions[0xfe]),
+	0xff: invalidInst(PrefixedInstructions[0xff]),
 }
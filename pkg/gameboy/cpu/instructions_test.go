@@ -0,0 +1,2565 @@
+// Code generated by instgen from opcodes.json. DO NOT EDIT.
+
+package cpu
+
+import "testing"
+
+// NOP
+func TestOpCode00(t *testing.T) {
+	testOpCode(t, false, 0x00)
+}
+
+// LD BC,u16
+func TestOpCode01(t *testing.T) {
+	testOpCode(t, false, 0x01)
+}
+
+// LD (BC),A
+func TestOpCode02(t *testing.T) {
+	testOpCode(t, false, 0x02)
+}
+
+// INC BC
+func TestOpCode03(t *testing.T) {
+	testOpCode(t, false, 0x03)
+}
+
+// INC B
+func TestOpCode04(t *testing.T) {
+	testOpCode(t, false, 0x04)
+}
+
+// DEC B
+func TestOpCode05(t *testing.T) {
+	testOpCode(t, false, 0x05)
+}
+
+// LD B,u8
+func TestOpCode06(t *testing.T) {
+	testOpCode(t, false, 0x06)
+}
+
+// RLCA
+func TestOpCode07(t *testing.T) {
+	t.Skip("RLCA not implemented")
+}
+
+// LD (u16),SP
+func TestOpCode08(t *testing.T) {
+	testOpCode(t, false, 0x08)
+}
+
+// ADD HL,BC
+func TestOpCode09(t *testing.T) {
+	testOpCode(t, false, 0x09)
+}
+
+// LD A,(BC)
+func TestOpCode0A(t *testing.T) {
+	testOpCode(t, false, 0x0a)
+}
+
+// DEC BC
+func TestOpCode0B(t *testing.T) {
+	testOpCode(t, false, 0x0b)
+}
+
+// INC C
+func TestOpCode0C(t *testing.T) {
+	testOpCode(t, false, 0x0c)
+}
+
+// DEC C
+func TestOpCode0D(t *testing.T) {
+	testOpCode(t, false, 0x0d)
+}
+
+// LD C,u8
+func TestOpCode0E(t *testing.T) {
+	testOpCode(t, false, 0x0e)
+}
+
+// RRCA
+func TestOpCode0F(t *testing.T) {
+	t.Skip("RRCA not implemented")
+}
+
+// STOP
+func TestOpCode10(t *testing.T) {
+	t.Skip("STOP not implemented")
+}
+
+// LD DE,u16
+func TestOpCode11(t *testing.T) {
+	testOpCode(t, false, 0x11)
+}
+
+// LD (DE),A
+func TestOpCode12(t *testing.T) {
+	testOpCode(t, false, 0x12)
+}
+
+// INC DE
+func TestOpCode13(t *testing.T) {
+	testOpCode(t, false, 0x13)
+}
+
+// INC D
+func TestOpCode14(t *testing.T) {
+	testOpCode(t, false, 0x14)
+}
+
+// DEC D
+func TestOpCode15(t *testing.T) {
+	testOpCode(t, false, 0x15)
+}
+
+// LD D,u8
+func TestOpCode16(t *testing.T) {
+	testOpCode(t, false, 0x16)
+}
+
+// RLA
+func TestOpCode17(t *testing.T) {
+	testOpCode(t, false, 0x17)
+}
+
+// JR i8
+func TestOpCode18(t *testing.T) {
+	testOpCode(t, false, 0x18)
+}
+
+// ADD HL,DE
+func TestOpCode19(t *testing.T) {
+	testOpCode(t, false, 0x19)
+}
+
+// LD A,(DE)
+func TestOpCode1A(t *testing.T) {
+	testOpCode(t, false, 0x1a)
+}
+
+// DEC DE
+func TestOpCode1B(t *testing.T) {
+	testOpCode(t, false, 0x1b)
+}
+
+// INC E
+func TestOpCode1C(t *testing.T) {
+	testOpCode(t, false, 0x1c)
+}
+
+// DEC E
+func TestOpCode1D(t *testing.T) {
+	testOpCode(t, false, 0x1d)
+}
+
+// LD E,u8
+func TestOpCode1E(t *testing.T) {
+	testOpCode(t, false, 0x1e)
+}
+
+// RRA
+func TestOpCode1F(t *testing.T) {
+	testOpCode(t, false, 0x1f)
+}
+
+// JR NZ,i8
+func TestOpCode20(t *testing.T) {
+	testOpCode(t, false, 0x20)
+}
+
+// LD HL,u16
+func TestOpCode21(t *testing.T) {
+	testOpCode(t, false, 0x21)
+}
+
+// LD (HL+),A
+func TestOpCode22(t *testing.T) {
+	testOpCode(t, false, 0x22)
+}
+
+// INC HL
+func TestOpCode23(t *testing.T) {
+	testOpCode(t, false, 0x23)
+}
+
+// INC H
+func TestOpCode24(t *testing.T) {
+	testOpCode(t, false, 0x24)
+}
+
+// DEC H
+func TestOpCode25(t *testing.T) {
+	testOpCode(t, false, 0x25)
+}
+
+// LD H,u8
+func TestOpCode26(t *testing.T) {
+	testOpCode(t, false, 0x26)
+}
+
+// DAA
+func TestOpCode27(t *testing.T) {
+	t.Skip("DAA not implemented")
+}
+
+// JR Z,i8
+func TestOpCode28(t *testing.T) {
+	testOpCode(t, false, 0x28)
+}
+
+// ADD HL,HL
+func TestOpCode29(t *testing.T) {
+	testOpCode(t, false, 0x29)
+}
+
+// LD A,(HL+)
+func TestOpCode2A(t *testing.T) {
+	testOpCode(t, false, 0x2a)
+}
+
+// DEC HL
+func TestOpCode2B(t *testing.T) {
+	testOpCode(t, false, 0x2b)
+}
+
+// INC L
+func TestOpCode2C(t *testing.T) {
+	testOpCode(t, false, 0x2c)
+}
+
+// DEC L
+func TestOpCode2D(t *testing.T) {
+	testOpCode(t, false, 0x2d)
+}
+
+// LD L,u8
+func TestOpCode2E(t *testing.T) {
+	testOpCode(t, false, 0x2e)
+}
+
+// CPL
+func TestOpCode2F(t *testing.T) {
+	testOpCode(t, false, 0x2f)
+}
+
+// JR NC,i8
+func TestOpCode30(t *testing.T) {
+	testOpCode(t, false, 0x30)
+}
+
+// LD SP,u16
+func TestOpCode31(t *testing.T) {
+	testOpCode(t, false, 0x31)
+}
+
+// LD (HL-),A
+func TestOpCode32(t *testing.T) {
+	testOpCode(t, false, 0x32)
+}
+
+// INC SP
+func TestOpCode33(t *testing.T) {
+	testOpCode(t, false, 0x33)
+}
+
+// INC (HL)
+func TestOpCode34(t *testing.T) {
+	testOpCode(t, false, 0x34)
+}
+
+// DEC (HL)
+func TestOpCode35(t *testing.T) {
+	testOpCode(t, false, 0x35)
+}
+
+// LD (HL),u8
+func TestOpCode36(t *testing.T) {
+	testOpCode(t, false, 0x36)
+}
+
+// SCF
+func TestOpCode37(t *testing.T) {
+	t.Skip("SCF not implemented")
+}
+
+// JR C,i8
+func TestOpCode38(t *testing.T) {
+	testOpCode(t, false, 0x38)
+}
+
+// ADD HL,SP
+func TestOpCode39(t *testing.T) {
+	testOpCode(t, false, 0x39)
+}
+
+// LD A,(HL-)
+func TestOpCode3A(t *testing.T) {
+	testOpCode(t, false, 0x3a)
+}
+
+// DEC SP
+func TestOpCode3B(t *testing.T) {
+	testOpCode(t, false, 0x3b)
+}
+
+// INC A
+func TestOpCode3C(t *testing.T) {
+	testOpCode(t, false, 0x3c)
+}
+
+// DEC A
+func TestOpCode3D(t *testing.T) {
+	testOpCode(t, false, 0x3d)
+}
+
+// LD A,u8
+func TestOpCode3E(t *testing.T) {
+	testOpCode(t, false, 0x3e)
+}
+
+// CCF
+func TestOpCode3F(t *testing.T) {
+	t.Skip("CCF not implemented")
+}
+
+// LD B,B
+func TestOpCode40(t *testing.T) {
+	testOpCode(t, false, 0x40)
+}
+
+// LD B,C
+func TestOpCode41(t *testing.T) {
+	testOpCode(t, false, 0x41)
+}
+
+// LD B,D
+func TestOpCode42(t *testing.T) {
+	testOpCode(t, false, 0x42)
+}
+
+// LD B,E
+func TestOpCode43(t *testing.T) {
+	testOpCode(t, false, 0x43)
+}
+
+// LD B,H
+func TestOpCode44(t *testing.T) {
+	testOpCode(t, false, 0x44)
+}
+
+// LD B,L
+func TestOpCode45(t *testing.T) {
+	testOpCode(t, false, 0x45)
+}
+
+// LD B,(HL)
+func TestOpCode46(t *testing.T) {
+	testOpCode(t, false, 0x46)
+}
+
+// LD B,A
+func TestOpCode47(t *testing.T) {
+	testOpCode(t, false, 0x47)
+}
+
+// LD C,B
+func TestOpCode48(t *testing.T) {
+	testOpCode(t, false, 0x48)
+}
+
+// LD C,C
+func TestOpCode49(t *testing.T) {
+	testOpCode(t, false, 0x49)
+}
+
+// LD C,D
+func TestOpCode4A(t *testing.T) {
+	testOpCode(t, false, 0x4a)
+}
+
+// LD C,E
+func TestOpCode4B(t *testing.T) {
+	testOpCode(t, false, 0x4b)
+}
+
+// LD C,H
+func TestOpCode4C(t *testing.T) {
+	testOpCode(t, false, 0x4c)
+}
+
+// LD C,L
+func TestOpCode4D(t *testing.T) {
+	testOpCode(t, false, 0x4d)
+}
+
+// LD C,(HL)
+func TestOpCode4E(t *testing.T) {
+	testOpCode(t, false, 0x4e)
+}
+
+// LD C,A
+func TestOpCode4F(t *testing.T) {
+	testOpCode(t, false, 0x4f)
+}
+
+// LD D,B
+func TestOpCode50(t *testing.T) {
+	testOpCode(t, false, 0x50)
+}
+
+// LD D,C
+func TestOpCode51(t *testing.T) {
+	testOpCode(t, false, 0x51)
+}
+
+// LD D,D
+func TestOpCode52(t *testing.T) {
+	testOpCode(t, false, 0x52)
+}
+
+// LD D,E
+func TestOpCode53(t *testing.T) {
+	testOpCode(t, false, 0x53)
+}
+
+// LD D,H
+func TestOpCode54(t *testing.T) {
+	testOpCode(t, false, 0x54)
+}
+
+// LD D,L
+func TestOpCode55(t *testing.T) {
+	testOpCode(t, false, 0x55)
+}
+
+// LD D,(HL)
+func TestOpCode56(t *testing.T) {
+	testOpCode(t, false, 0x56)
+}
+
+// LD D,A
+func TestOpCode57(t *testing.T) {
+	testOpCode(t, false, 0x57)
+}
+
+// LD E,B
+func TestOpCode58(t *testing.T) {
+	testOpCode(t, false, 0x58)
+}
+
+// LD E,C
+func TestOpCode59(t *testing.T) {
+	testOpCode(t, false, 0x59)
+}
+
+// LD E,D
+func TestOpCode5A(t *testing.T) {
+	testOpCode(t, false, 0x5a)
+}
+
+// LD E,E
+func TestOpCode5B(t *testing.T) {
+	testOpCode(t, false, 0x5b)
+}
+
+// LD E,H
+func TestOpCode5C(t *testing.T) {
+	testOpCode(t, false, 0x5c)
+}
+
+// LD E,L
+func TestOpCode5D(t *testing.T) {
+	testOpCode(t, false, 0x5d)
+}
+
+// LD E,(HL)
+func TestOpCode5E(t *testing.T) {
+	testOpCode(t, false, 0x5e)
+}
+
+// LD E,A
+func TestOpCode5F(t *testing.T) {
+	testOpCode(t, false, 0x5f)
+}
+
+// LD H,B
+func TestOpCode60(t *testing.T) {
+	testOpCode(t, false, 0x60)
+}
+
+// LD H,C
+func TestOpCode61(t *testing.T) {
+	testOpCode(t, false, 0x61)
+}
+
+// LD H,D
+func TestOpCode62(t *testing.T) {
+	testOpCode(t, false, 0x62)
+}
+
+// LD H,E
+func TestOpCode63(t *testing.T) {
+	testOpCode(t, false, 0x63)
+}
+
+// LD H,H
+func TestOpCode64(t *testing.T) {
+	testOpCode(t, false, 0x64)
+}
+
+// LD H,L
+func TestOpCode65(t *testing.T) {
+	testOpCode(t, false, 0x65)
+}
+
+// LD H,(HL)
+func TestOpCode66(t *testing.T) {
+	testOpCode(t, false, 0x66)
+}
+
+// LD H,A
+func TestOpCode67(t *testing.T) {
+	testOpCode(t, false, 0x67)
+}
+
+// LD L,B
+func TestOpCode68(t *testing.T) {
+	testOpCode(t, false, 0x68)
+}
+
+// LD L,C
+func TestOpCode69(t *testing.T) {
+	testOpCode(t, false, 0x69)
+}
+
+// LD L,D
+func TestOpCode6A(t *testing.T) {
+	testOpCode(t, false, 0x6a)
+}
+
+// LD L,E
+func TestOpCode6B(t *testing.T) {
+	testOpCode(t, false, 0x6b)
+}
+
+// LD L,H
+func TestOpCode6C(t *testing.T) {
+	testOpCode(t, false, 0x6c)
+}
+
+// LD L,L
+func TestOpCode6D(t *testing.T) {
+	testOpCode(t, false, 0x6d)
+}
+
+// LD L,(HL)
+func TestOpCode6E(t *testing.T) {
+	testOpCode(t, false, 0x6e)
+}
+
+// LD L,A
+func TestOpCode6F(t *testing.T) {
+	testOpCode(t, false, 0x6f)
+}
+
+// LD (HL),B
+func TestOpCode70(t *testing.T) {
+	testOpCode(t, false, 0x70)
+}
+
+// LD (HL),C
+func TestOpCode71(t *testing.T) {
+	testOpCode(t, false, 0x71)
+}
+
+// LD (HL),D
+func TestOpCode72(t *testing.T) {
+	testOpCode(t, false, 0x72)
+}
+
+// LD (HL),E
+func TestOpCode73(t *testing.T) {
+	testOpCode(t, false, 0x73)
+}
+
+// LD (HL),H
+func TestOpCode74(t *testing.T) {
+	testOpCode(t, false, 0x74)
+}
+
+// LD (HL),L
+func TestOpCode75(t *testing.T) {
+	testOpCode(t, false, 0x75)
+}
+
+// HALT
+func TestOpCode76(t *testing.T) {
+	t.Skip("HALT not implemented")
+}
+
+// LD (HL),A
+func TestOpCode77(t *testing.T) {
+	testOpCode(t, false, 0x77)
+}
+
+// LD A,B
+func TestOpCode78(t *testing.T) {
+	testOpCode(t, false, 0x78)
+}
+
+// LD A,C
+func TestOpCode79(t *testing.T) {
+	testOpCode(t, false, 0x79)
+}
+
+// LD A,D
+func TestOpCode7A(t *testing.T) {
+	testOpCode(t, false, 0x7a)
+}
+
+// LD A,E
+func TestOpCode7B(t *testing.T) {
+	testOpCode(t, false, 0x7b)
+}
+
+// LD A,H
+func TestOpCode7C(t *testing.T) {
+	testOpCode(t, false, 0x7c)
+}
+
+// LD A,L
+func TestOpCode7D(t *testing.T) {
+	testOpCode(t, false, 0x7d)
+}
+
+// LD A,(HL)
+func TestOpCode7E(t *testing.T) {
+	testOpCode(t, false, 0x7e)
+}
+
+// LD A,A
+func TestOpCode7F(t *testing.T) {
+	testOpCode(t, false, 0x7f)
+}
+
+// ADD A,B
+func TestOpCode80(t *testing.T) {
+	testOpCode(t, false, 0x80)
+}
+
+// ADD A,C
+func TestOpCode81(t *testing.T) {
+	testOpCode(t, false, 0x81)
+}
+
+// ADD A,D
+func TestOpCode82(t *testing.T) {
+	testOpCode(t, false, 0x82)
+}
+
+// ADD A,E
+func TestOpCode83(t *testing.T) {
+	testOpCode(t, false, 0x83)
+}
+
+// ADD A,H
+func TestOpCode84(t *testing.T) {
+	testOpCode(t, false, 0x84)
+}
+
+// ADD A,L
+func TestOpCode85(t *testing.T) {
+	testOpCode(t, false, 0x85)
+}
+
+// ADD A,(HL)
+func TestOpCode86(t *testing.T) {
+	testOpCode(t, false, 0x86)
+}
+
+// ADD A,A
+func TestOpCode87(t *testing.T) {
+	testOpCode(t, false, 0x87)
+}
+
+// ADC A,B
+func TestOpCode88(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,C
+func TestOpCode89(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,D
+func TestOpCode8A(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,E
+func TestOpCode8B(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,H
+func TestOpCode8C(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,L
+func TestOpCode8D(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,(HL)
+func TestOpCode8E(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// ADC A,A
+func TestOpCode8F(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// SUB A,B
+func TestOpCode90(t *testing.T) {
+	testOpCode(t, false, 0x90)
+}
+
+// SUB A,C
+func TestOpCode91(t *testing.T) {
+	testOpCode(t, false, 0x91)
+}
+
+// SUB A,D
+func TestOpCode92(t *testing.T) {
+	testOpCode(t, false, 0x92)
+}
+
+// SUB A,E
+func TestOpCode93(t *testing.T) {
+	testOpCode(t, false, 0x93)
+}
+
+// SUB A,H
+func TestOpCode94(t *testing.T) {
+	testOpCode(t, false, 0x94)
+}
+
+// SUB A,L
+func TestOpCode95(t *testing.T) {
+	testOpCode(t, false, 0x95)
+}
+
+// SUB A,(HL)
+func TestOpCode96(t *testing.T) {
+	testOpCode(t, false, 0x96)
+}
+
+// SUB A,A
+func TestOpCode97(t *testing.T) {
+	testOpCode(t, false, 0x97)
+}
+
+// SBC A,B
+func TestOpCode98(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,C
+func TestOpCode99(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,D
+func TestOpCode9A(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,E
+func TestOpCode9B(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,H
+func TestOpCode9C(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,L
+func TestOpCode9D(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,(HL)
+func TestOpCode9E(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// SBC A,A
+func TestOpCode9F(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// AND A,B
+func TestOpCodeA0(t *testing.T) {
+	testOpCode(t, false, 0xa0)
+}
+
+// AND A,C
+func TestOpCodeA1(t *testing.T) {
+	testOpCode(t, false, 0xa1)
+}
+
+// AND A,D
+func TestOpCodeA2(t *testing.T) {
+	testOpCode(t, false, 0xa2)
+}
+
+// AND A,E
+func TestOpCodeA3(t *testing.T) {
+	testOpCode(t, false, 0xa3)
+}
+
+// AND A,H
+func TestOpCodeA4(t *testing.T) {
+	testOpCode(t, false, 0xa4)
+}
+
+// AND A,L
+func TestOpCodeA5(t *testing.T) {
+	testOpCode(t, false, 0xa5)
+}
+
+// AND A,(HL)
+func TestOpCodeA6(t *testing.T) {
+	testOpCode(t, false, 0xa6)
+}
+
+// AND A,A
+func TestOpCodeA7(t *testing.T) {
+	testOpCode(t, false, 0xa7)
+}
+
+// XOR A,B
+func TestOpCodeA8(t *testing.T) {
+	testOpCode(t, false, 0xa8)
+}
+
+// XOR A,C
+func TestOpCodeA9(t *testing.T) {
+	testOpCode(t, false, 0xa9)
+}
+
+// XOR A,D
+func TestOpCodeAA(t *testing.T) {
+	testOpCode(t, false, 0xaa)
+}
+
+// XOR A,E
+func TestOpCodeAB(t *testing.T) {
+	testOpCode(t, false, 0xab)
+}
+
+// XOR A,H
+func TestOpCodeAC(t *testing.T) {
+	testOpCode(t, false, 0xac)
+}
+
+// XOR A,L
+func TestOpCodeAD(t *testing.T) {
+	testOpCode(t, false, 0xad)
+}
+
+// XOR A,(HL)
+func TestOpCodeAE(t *testing.T) {
+	testOpCode(t, false, 0xae)
+}
+
+// XOR A,A
+func TestOpCodeAF(t *testing.T) {
+	testOpCode(t, false, 0xaf)
+}
+
+// OR A,B
+func TestOpCodeB0(t *testing.T) {
+	testOpCode(t, false, 0xb0)
+}
+
+// OR A,C
+func TestOpCodeB1(t *testing.T) {
+	testOpCode(t, false, 0xb1)
+}
+
+// OR A,D
+func TestOpCodeB2(t *testing.T) {
+	testOpCode(t, false, 0xb2)
+}
+
+// OR A,E
+func TestOpCodeB3(t *testing.T) {
+	testOpCode(t, false, 0xb3)
+}
+
+// OR A,H
+func TestOpCodeB4(t *testing.T) {
+	testOpCode(t, false, 0xb4)
+}
+
+// OR A,L
+func TestOpCodeB5(t *testing.T) {
+	testOpCode(t, false, 0xb5)
+}
+
+// OR A,(HL)
+func TestOpCodeB6(t *testing.T) {
+	testOpCode(t, false, 0xb6)
+}
+
+// OR A,A
+func TestOpCodeB7(t *testing.T) {
+	testOpCode(t, false, 0xb7)
+}
+
+// CP A,B
+func TestOpCodeB8(t *testing.T) {
+	testOpCode(t, false, 0xb8)
+}
+
+// CP A,C
+func TestOpCodeB9(t *testing.T) {
+	testOpCode(t, false, 0xb9)
+}
+
+// CP A,D
+func TestOpCodeBA(t *testing.T) {
+	testOpCode(t, false, 0xba)
+}
+
+// CP A,E
+func TestOpCodeBB(t *testing.T) {
+	testOpCode(t, false, 0xbb)
+}
+
+// CP A,H
+func TestOpCodeBC(t *testing.T) {
+	testOpCode(t, false, 0xbc)
+}
+
+// CP A,L
+func TestOpCodeBD(t *testing.T) {
+	testOpCode(t, false, 0xbd)
+}
+
+// CP A,(HL)
+func TestOpCodeBE(t *testing.T) {
+	testOpCode(t, false, 0xbe)
+}
+
+// CP A,A
+func TestOpCodeBF(t *testing.T) {
+	testOpCode(t, false, 0xbf)
+}
+
+// RET NZ
+func TestOpCodeC0(t *testing.T) {
+	testOpCode(t, false, 0xc0)
+}
+
+// POP BC
+func TestOpCodeC1(t *testing.T) {
+	testOpCode(t, false, 0xc1)
+}
+
+// JP NZ,u16
+func TestOpCodeC2(t *testing.T) {
+	testOpCode(t, false, 0xc2)
+}
+
+// JP u16
+func TestOpCodeC3(t *testing.T) {
+	testOpCode(t, false, 0xc3)
+}
+
+// CALL NZ,u16
+func TestOpCodeC4(t *testing.T) {
+	testOpCode(t, false, 0xc4)
+}
+
+// PUSH BC
+func TestOpCodeC5(t *testing.T) {
+	testOpCode(t, false, 0xc5)
+}
+
+// ADD A,u8
+func TestOpCodeC6(t *testing.T) {
+	testOpCode(t, false, 0xc6)
+}
+
+// RST 00h
+func TestOpCodeC7(t *testing.T) {
+	testOpCode(t, false, 0xc7)
+}
+
+// RET Z
+func TestOpCodeC8(t *testing.T) {
+	testOpCode(t, false, 0xc8)
+}
+
+// RET
+func TestOpCodeC9(t *testing.T) {
+	testOpCode(t, false, 0xc9)
+}
+
+// JP Z,u16
+func TestOpCodeCA(t *testing.T) {
+	testOpCode(t, false, 0xca)
+}
+
+// PREFIX CB
+func TestOpCodeCB(t *testing.T) {
+	testOpCode(t, false, 0xcb)
+}
+
+// CALL Z,u16
+func TestOpCodeCC(t *testing.T) {
+	testOpCode(t, false, 0xcc)
+}
+
+// CALL u16
+func TestOpCodeCD(t *testing.T) {
+	testOpCode(t, false, 0xcd)
+}
+
+// ADC A,u8
+func TestOpCodeCE(t *testing.T) {
+	t.Skip("ADC not implemented")
+}
+
+// RST 08h
+func TestOpCodeCF(t *testing.T) {
+	testOpCode(t, false, 0xcf)
+}
+
+// RET NC
+func TestOpCodeD0(t *testing.T) {
+	testOpCode(t, false, 0xd0)
+}
+
+// POP DE
+func TestOpCodeD1(t *testing.T) {
+	testOpCode(t, false, 0xd1)
+}
+
+// JP NC,u16
+func TestOpCodeD2(t *testing.T) {
+	testOpCode(t, false, 0xd2)
+}
+
+// UNUSED
+func TestOpCodeD3(t *testing.T) {
+	testOpCode(t, false, 0xd3)
+}
+
+// CALL NC,u16
+func TestOpCodeD4(t *testing.T) {
+	testOpCode(t, false, 0xd4)
+}
+
+// PUSH DE
+func TestOpCodeD5(t *testing.T) {
+	testOpCode(t, false, 0xd5)
+}
+
+// SUB A,u8
+func TestOpCodeD6(t *testing.T) {
+	testOpCode(t, false, 0xd6)
+}
+
+// RST 10h
+func TestOpCodeD7(t *testing.T) {
+	testOpCode(t, false, 0xd7)
+}
+
+// RET C
+func TestOpCodeD8(t *testing.T) {
+	testOpCode(t, false, 0xd8)
+}
+
+// RETI
+func TestOpCodeD9(t *testing.T) {
+	t.Skip("RETI not implemented")
+}
+
+// JP C,u16
+func TestOpCodeDA(t *testing.T) {
+	testOpCode(t, false, 0xda)
+}
+
+// UNUSED
+func TestOpCodeDB(t *testing.T) {
+	testOpCode(t, false, 0xdb)
+}
+
+// CALL C,u16
+func TestOpCodeDC(t *testing.T) {
+	testOpCode(t, false, 0xdc)
+}
+
+// UNUSED
+func TestOpCodeDD(t *testing.T) {
+	testOpCode(t, false, 0xdd)
+}
+
+// SBC A,u8
+func TestOpCodeDE(t *testing.T) {
+	t.Skip("SBC not implemented")
+}
+
+// RST 18h
+func TestOpCodeDF(t *testing.T) {
+	testOpCode(t, false, 0xdf)
+}
+
+// LD (FF00+u8),A
+func TestOpCodeE0(t *testing.T) {
+	testOpCode(t, false, 0xe0)
+}
+
+// POP HL
+func TestOpCodeE1(t *testing.T) {
+	testOpCode(t, false, 0xe1)
+}
+
+// LD (FF00+C),A
+func TestOpCodeE2(t *testing.T) {
+	testOpCode(t, false, 0xe2)
+}
+
+// UNUSED
+func TestOpCodeE3(t *testing.T) {
+	testOpCode(t, false, 0xe3)
+}
+
+// UNUSED
+func TestOpCodeE4(t *testing.T) {
+	testOpCode(t, false, 0xe4)
+}
+
+// PUSH HL
+func TestOpCodeE5(t *testing.T) {
+	testOpCode(t, false, 0xe5)
+}
+
+// AND A,u8
+func TestOpCodeE6(t *testing.T) {
+	testOpCode(t, false, 0xe6)
+}
+
+// RST 20h
+func TestOpCodeE7(t *testing.T) {
+	testOpCode(t, false, 0xe7)
+}
+
+// ADD SP,i8
+func TestOpCodeE8(t *testing.T) {
+	testOpCode(t, false, 0xe8)
+}
+
+// JP HL
+func TestOpCodeE9(t *testing.T) {
+	testOpCode(t, false, 0xe9)
+}
+
+// LD (u16),A
+func TestOpCodeEA(t *testing.T) {
+	testOpCode(t, false, 0xea)
+}
+
+// UNUSED
+func TestOpCodeEB(t *testing.T) {
+	testOpCode(t, false, 0xeb)
+}
+
+// UNUSED
+func TestOpCodeEC(t *testing.T) {
+	testOpCode(t, false, 0xec)
+}
+
+// UNUSED
+func TestOpCodeED(t *testing.T) {
+	testOpCode(t, false, 0xed)
+}
+
+// XOR A,u8
+func TestOpCodeEE(t *testing.T) {
+	testOpCode(t, false, 0xee)
+}
+
+// RST 28h
+func TestOpCodeEF(t *testing.T) {
+	testOpCode(t, false, 0xef)
+}
+
+// LD A,(FF00+u8)
+func TestOpCodeF0(t *testing.T) {
+	testOpCode(t, false, 0xf0)
+}
+
+// POP AF
+func TestOpCodeF1(t *testing.T) {
+	testOpCode(t, false, 0xf1)
+}
+
+// LD A,(FF00+C)
+func TestOpCodeF2(t *testing.T) {
+	testOpCode(t, false, 0xf2)
+}
+
+// DI
+func TestOpCodeF3(t *testing.T) {
+	testOpCode(t, false, 0xf3)
+}
+
+// UNUSED
+func TestOpCodeF4(t *testing.T) {
+	testOpCode(t, false, 0xf4)
+}
+
+// PUSH AF
+func TestOpCodeF5(t *testing.T) {
+	testOpCode(t, false, 0xf5)
+}
+
+// OR A,u8
+func TestOpCodeF6(t *testing.T) {
+	testOpCode(t, false, 0xf6)
+}
+
+// RST 30h
+func TestOpCodeF7(t *testing.T) {
+	testOpCode(t, false, 0xf7)
+}
+
+// LD HL,SP+i8
+func TestOpCodeF8(t *testing.T) {
+	testOpCode(t, false, 0xf8)
+}
+
+// LD SP,HL
+func TestOpCodeF9(t *testing.T) {
+	testOpCode(t, false, 0xf9)
+}
+
+// LD A,(u16)
+func TestOpCodeFA(t *testing.T) {
+	testOpCode(t, false, 0xfa)
+}
+
+// EI
+func TestOpCodeFB(t *testing.T) {
+	testOpCode(t, false, 0xfb)
+}
+
+// UNUSED
+func TestOpCodeFC(t *testing.T) {
+	testOpCode(t, false, 0xfc)
+}
+
+// UNUSED
+func TestOpCodeFD(t *testing.T) {
+	testOpCode(t, false, 0xfd)
+}
+
+// CP A,u8
+func TestOpCodeFE(t *testing.T) {
+	testOpCode(t, false, 0xfe)
+}
+
+// RST 38h
+func TestOpCodeFF(t *testing.T) {
+	testOpCode(t, false, 0xff)
+}
+
+// RLC B
+func TestPrefixedOpCode00(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC C
+func TestPrefixedOpCode01(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC D
+func TestPrefixedOpCode02(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC E
+func TestPrefixedOpCode03(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC H
+func TestPrefixedOpCode04(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC L
+func TestPrefixedOpCode05(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC (HL)
+func TestPrefixedOpCode06(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RLC A
+func TestPrefixedOpCode07(t *testing.T) {
+	t.Skip("RLC not implemented")
+}
+
+// RRC B
+func TestPrefixedOpCode08(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC C
+func TestPrefixedOpCode09(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC D
+func TestPrefixedOpCode0A(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC E
+func TestPrefixedOpCode0B(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC H
+func TestPrefixedOpCode0C(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC L
+func TestPrefixedOpCode0D(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC (HL)
+func TestPrefixedOpCode0E(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RRC A
+func TestPrefixedOpCode0F(t *testing.T) {
+	t.Skip("RRC not implemented")
+}
+
+// RL B
+func TestPrefixedOpCode10(t *testing.T) {
+	testOpCode(t, true, 0x10)
+}
+
+// RL C
+func TestPrefixedOpCode11(t *testing.T) {
+	testOpCode(t, true, 0x11)
+}
+
+// RL D
+func TestPrefixedOpCode12(t *testing.T) {
+	testOpCode(t, true, 0x12)
+}
+
+// RL E
+func TestPrefixedOpCode13(t *testing.T) {
+	testOpCode(t, true, 0x13)
+}
+
+// RL H
+func TestPrefixedOpCode14(t *testing.T) {
+	testOpCode(t, true, 0x14)
+}
+
+// RL L
+func TestPrefixedOpCode15(t *testing.T) {
+	testOpCode(t, true, 0x15)
+}
+
+// RL (HL)
+func TestPrefixedOpCode16(t *testing.T) {
+	testOpCode(t, true, 0x16)
+}
+
+// RL A
+func TestPrefixedOpCode17(t *testing.T) {
+	testOpCode(t, true, 0x17)
+}
+
+// RR B
+func TestPrefixedOpCode18(t *testing.T) {
+	testOpCode(t, true, 0x18)
+}
+
+// RR C
+func TestPrefixedOpCode19(t *testing.T) {
+	testOpCode(t, true, 0x19)
+}
+
+// RR D
+func TestPrefixedOpCode1A(t *testing.T) {
+	testOpCode(t, true, 0x1a)
+}
+
+// RR E
+func TestPrefixedOpCode1B(t *testing.T) {
+	testOpCode(t, true, 0x1b)
+}
+
+// RR H
+func TestPrefixedOpCode1C(t *testing.T) {
+	testOpCode(t, true, 0x1c)
+}
+
+// RR L
+func TestPrefixedOpCode1D(t *testing.T) {
+	testOpCode(t, true, 0x1d)
+}
+
+// RR (HL)
+func TestPrefixedOpCode1E(t *testing.T) {
+	testOpCode(t, true, 0x1e)
+}
+
+// RR A
+func TestPrefixedOpCode1F(t *testing.T) {
+	testOpCode(t, true, 0x1f)
+}
+
+// SLA B
+func TestPrefixedOpCode20(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA C
+func TestPrefixedOpCode21(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA D
+func TestPrefixedOpCode22(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA E
+func TestPrefixedOpCode23(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA H
+func TestPrefixedOpCode24(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA L
+func TestPrefixedOpCode25(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA (HL)
+func TestPrefixedOpCode26(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SLA A
+func TestPrefixedOpCode27(t *testing.T) {
+	t.Skip("SLA not implemented")
+}
+
+// SRA B
+func TestPrefixedOpCode28(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA C
+func TestPrefixedOpCode29(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA D
+func TestPrefixedOpCode2A(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA E
+func TestPrefixedOpCode2B(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA H
+func TestPrefixedOpCode2C(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA L
+func TestPrefixedOpCode2D(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA (HL)
+func TestPrefixedOpCode2E(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SRA A
+func TestPrefixedOpCode2F(t *testing.T) {
+	t.Skip("SRA not implemented")
+}
+
+// SWAP B
+func TestPrefixedOpCode30(t *testing.T) {
+	testOpCode(t, true, 0x30)
+}
+
+// SWAP C
+func TestPrefixedOpCode31(t *testing.T) {
+	testOpCode(t, true, 0x31)
+}
+
+// SWAP D
+func TestPrefixedOpCode32(t *testing.T) {
+	testOpCode(t, true, 0x32)
+}
+
+// SWAP E
+func TestPrefixedOpCode33(t *testing.T) {
+	testOpCode(t, true, 0x33)
+}
+
+// SWAP H
+func TestPrefixedOpCode34(t *testing.T) {
+	testOpCode(t, true, 0x34)
+}
+
+// SWAP L
+func TestPrefixedOpCode35(t *testing.T) {
+	testOpCode(t, true, 0x35)
+}
+
+// SWAP (HL)
+func TestPrefixedOpCode36(t *testing.T) {
+	testOpCode(t, true, 0x36)
+}
+
+// SWAP A
+func TestPrefixedOpCode37(t *testing.T) {
+	testOpCode(t, true, 0x37)
+}
+
+// SRL B
+func TestPrefixedOpCode38(t *testing.T) {
+	testOpCode(t, true, 0x38)
+}
+
+// SRL C
+func TestPrefixedOpCode39(t *testing.T) {
+	testOpCode(t, true, 0x39)
+}
+
+// SRL D
+func TestPrefixedOpCode3A(t *testing.T) {
+	testOpCode(t, true, 0x3a)
+}
+
+// SRL E
+func TestPrefixedOpCode3B(t *testing.T) {
+	testOpCode(t, true, 0x3b)
+}
+
+// SRL H
+func TestPrefixedOpCode3C(t *testing.T) {
+	testOpCode(t, true, 0x3c)
+}
+
+// SRL L
+func TestPrefixedOpCode3D(t *testing.T) {
+	testOpCode(t, true, 0x3d)
+}
+
+// SRL (HL)
+func TestPrefixedOpCode3E(t *testing.T) {
+	testOpCode(t, true, 0x3e)
+}
+
+// SRL A
+func TestPrefixedOpCode3F(t *testing.T) {
+	testOpCode(t, true, 0x3f)
+}
+
+// BIT 0,B
+func TestPrefixedOpCode40(t *testing.T) {
+	testOpCode(t, true, 0x40)
+}
+
+// BIT 0,C
+func TestPrefixedOpCode41(t *testing.T) {
+	testOpCode(t, true, 0x41)
+}
+
+// BIT 0,D
+func TestPrefixedOpCode42(t *testing.T) {
+	testOpCode(t, true, 0x42)
+}
+
+// BIT 0,E
+func TestPrefixedOpCode43(t *testing.T) {
+	testOpCode(t, true, 0x43)
+}
+
+// BIT 0,H
+func TestPrefixedOpCode44(t *testing.T) {
+	testOpCode(t, true, 0x44)
+}
+
+// BIT 0,L
+func TestPrefixedOpCode45(t *testing.T) {
+	testOpCode(t, true, 0x45)
+}
+
+// BIT 0,(HL)
+func TestPrefixedOpCode46(t *testing.T) {
+	testOpCode(t, true, 0x46)
+}
+
+// BIT 0,A
+func TestPrefixedOpCode47(t *testing.T) {
+	testOpCode(t, true, 0x47)
+}
+
+// BIT 1,B
+func TestPrefixedOpCode48(t *testing.T) {
+	testOpCode(t, true, 0x48)
+}
+
+// BIT 1,C
+func TestPrefixedOpCode49(t *testing.T) {
+	testOpCode(t, true, 0x49)
+}
+
+// BIT 1,D
+func TestPrefixedOpCode4A(t *testing.T) {
+	testOpCode(t, true, 0x4a)
+}
+
+// BIT 1,E
+func TestPrefixedOpCode4B(t *testing.T) {
+	testOpCode(t, true, 0x4b)
+}
+
+// BIT 1,H
+func TestPrefixedOpCode4C(t *testing.T) {
+	testOpCode(t, true, 0x4c)
+}
+
+// BIT 1,L
+func TestPrefixedOpCode4D(t *testing.T) {
+	testOpCode(t, true, 0x4d)
+}
+
+// BIT 1,(HL)
+func TestPrefixedOpCode4E(t *testing.T) {
+	testOpCode(t, true, 0x4e)
+}
+
+// BIT 1,A
+func TestPrefixedOpCode4F(t *testing.T) {
+	testOpCode(t, true, 0x4f)
+}
+
+// BIT 2,B
+func TestPrefixedOpCode50(t *testing.T) {
+	testOpCode(t, true, 0x50)
+}
+
+// BIT 2,C
+func TestPrefixedOpCode51(t *testing.T) {
+	testOpCode(t, true, 0x51)
+}
+
+// BIT 2,D
+func TestPrefixedOpCode52(t *testing.T) {
+	testOpCode(t, true, 0x52)
+}
+
+// BIT 2,E
+func TestPrefixedOpCode53(t *testing.T) {
+	testOpCode(t, true, 0x53)
+}
+
+// BIT 2,H
+func TestPrefixedOpCode54(t *testing.T) {
+	testOpCode(t, true, 0x54)
+}
+
+// BIT 2,L
+func TestPrefixedOpCode55(t *testing.T) {
+	testOpCode(t, true, 0x55)
+}
+
+// BIT 2,(HL)
+func TestPrefixedOpCode56(t *testing.T) {
+	testOpCode(t, true, 0x56)
+}
+
+// BIT 2,A
+func TestPrefixedOpCode57(t *testing.T) {
+	testOpCode(t, true, 0x57)
+}
+
+// BIT 3,B
+func TestPrefixedOpCode58(t *testing.T) {
+	testOpCode(t, true, 0x58)
+}
+
+// BIT 3,C
+func TestPrefixedOpCode59(t *testing.T) {
+	testOpCode(t, true, 0x59)
+}
+
+// BIT 3,D
+func TestPrefixedOpCode5A(t *testing.T) {
+	testOpCode(t, true, 0x5a)
+}
+
+// BIT 3,E
+func TestPrefixedOpCode5B(t *testing.T) {
+	testOpCode(t, true, 0x5b)
+}
+
+// BIT 3,H
+func TestPrefixedOpCode5C(t *testing.T) {
+	testOpCode(t, true, 0x5c)
+}
+
+// BIT 3,L
+func TestPrefixedOpCode5D(t *testing.T) {
+	testOpCode(t, true, 0x5d)
+}
+
+// BIT 3,(HL)
+func TestPrefixedOpCode5E(t *testing.T) {
+	testOpCode(t, true, 0x5e)
+}
+
+// BIT 3,A
+func TestPrefixedOpCode5F(t *testing.T) {
+	testOpCode(t, true, 0x5f)
+}
+
+// BIT 4,B
+func TestPrefixedOpCode60(t *testing.T) {
+	testOpCode(t, true, 0x60)
+}
+
+// BIT 4,C
+func TestPrefixedOpCode61(t *testing.T) {
+	testOpCode(t, true, 0x61)
+}
+
+// BIT 4,D
+func TestPrefixedOpCode62(t *testing.T) {
+	testOpCode(t, true, 0x62)
+}
+
+// BIT 4,E
+func TestPrefixedOpCode63(t *testing.T) {
+	testOpCode(t, true, 0x63)
+}
+
+// BIT 4,H
+func TestPrefixedOpCode64(t *testing.T) {
+	testOpCode(t, true, 0x64)
+}
+
+// BIT 4,L
+func TestPrefixedOpCode65(t *testing.T) {
+	testOpCode(t, true, 0x65)
+}
+
+// BIT 4,(HL)
+func TestPrefixedOpCode66(t *testing.T) {
+	testOpCode(t, true, 0x66)
+}
+
+// BIT 4,A
+func TestPrefixedOpCode67(t *testing.T) {
+	testOpCode(t, true, 0x67)
+}
+
+// BIT 5,B
+func TestPrefixedOpCode68(t *testing.T) {
+	testOpCode(t, true, 0x68)
+}
+
+// BIT 5,C
+func TestPrefixedOpCode69(t *testing.T) {
+	testOpCode(t, true, 0x69)
+}
+
+// BIT 5,D
+func TestPrefixedOpCode6A(t *testing.T) {
+	testOpCode(t, true, 0x6a)
+}
+
+// BIT 5,E
+func TestPrefixedOpCode6B(t *testing.T) {
+	testOpCode(t, true, 0x6b)
+}
+
+// BIT 5,H
+func TestPrefixedOpCode6C(t *testing.T) {
+	testOpCode(t, true, 0x6c)
+}
+
+// BIT 5,L
+func TestPrefixedOpCode6D(t *testing.T) {
+	testOpCode(t, true, 0x6d)
+}
+
+// BIT 5,(HL)
+func TestPrefixedOpCode6E(t *testing.T) {
+	testOpCode(t, true, 0x6e)
+}
+
+// BIT 5,A
+func TestPrefixedOpCode6F(t *testing.T) {
+	testOpCode(t, true, 0x6f)
+}
+
+// BIT 6,B
+func TestPrefixedOpCode70(t *testing.T) {
+	testOpCode(t, true, 0x70)
+}
+
+// BIT 6,C
+func TestPrefixedOpCode71(t *testing.T) {
+	testOpCode(t, true, 0x71)
+}
+
+// BIT 6,D
+func TestPrefixedOpCode72(t *testing.T) {
+	testOpCode(t, true, 0x72)
+}
+
+// BIT 6,E
+func TestPrefixedOpCode73(t *testing.T) {
+	testOpCode(t, true, 0x73)
+}
+
+// BIT 6,H
+func TestPrefixedOpCode74(t *testing.T) {
+	testOpCode(t, true, 0x74)
+}
+
+// BIT 6,L
+func TestPrefixedOpCode75(t *testing.T) {
+	testOpCode(t, true, 0x75)
+}
+
+// BIT 6,(HL)
+func TestPrefixedOpCode76(t *testing.T) {
+	testOpCode(t, true, 0x76)
+}
+
+// BIT 6,A
+func TestPrefixedOpCode77(t *testing.T) {
+	testOpCode(t, true, 0x77)
+}
+
+// BIT 7,B
+func TestPrefixedOpCode78(t *testing.T) {
+	testOpCode(t, true, 0x78)
+}
+
+// BIT 7,C
+func TestPrefixedOpCode79(t *testing.T) {
+	testOpCode(t, true, 0x79)
+}
+
+// BIT 7,D
+func TestPrefixedOpCode7A(t *testing.T) {
+	testOpCode(t, true, 0x7a)
+}
+
+// BIT 7,E
+func TestPrefixedOpCode7B(t *testing.T) {
+	testOpCode(t, true, 0x7b)
+}
+
+// BIT 7,H
+func TestPrefixedOpCode7C(t *testing.T) {
+	testOpCode(t, true, 0x7c)
+}
+
+// BIT 7,L
+func TestPrefixedOpCode7D(t *testing.T) {
+	testOpCode(t, true, 0x7d)
+}
+
+// BIT 7,(HL)
+func TestPrefixedOpCode7E(t *testing.T) {
+	testOpCode(t, true, 0x7e)
+}
+
+// BIT 7,A
+func TestPrefixedOpCode7F(t *testing.T) {
+	testOpCode(t, true, 0x7f)
+}
+
+// RES 0,B
+func TestPrefixedOpCode80(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,C
+func TestPrefixedOpCode81(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,D
+func TestPrefixedOpCode82(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,E
+func TestPrefixedOpCode83(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,H
+func TestPrefixedOpCode84(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,L
+func TestPrefixedOpCode85(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,(HL)
+func TestPrefixedOpCode86(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 0,A
+func TestPrefixedOpCode87(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,B
+func TestPrefixedOpCode88(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,C
+func TestPrefixedOpCode89(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,D
+func TestPrefixedOpCode8A(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,E
+func TestPrefixedOpCode8B(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,H
+func TestPrefixedOpCode8C(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,L
+func TestPrefixedOpCode8D(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,(HL)
+func TestPrefixedOpCode8E(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 1,A
+func TestPrefixedOpCode8F(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,B
+func TestPrefixedOpCode90(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,C
+func TestPrefixedOpCode91(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,D
+func TestPrefixedOpCode92(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,E
+func TestPrefixedOpCode93(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,H
+func TestPrefixedOpCode94(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,L
+func TestPrefixedOpCode95(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,(HL)
+func TestPrefixedOpCode96(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 2,A
+func TestPrefixedOpCode97(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,B
+func TestPrefixedOpCode98(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,C
+func TestPrefixedOpCode99(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,D
+func TestPrefixedOpCode9A(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,E
+func TestPrefixedOpCode9B(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,H
+func TestPrefixedOpCode9C(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,L
+func TestPrefixedOpCode9D(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,(HL)
+func TestPrefixedOpCode9E(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 3,A
+func TestPrefixedOpCode9F(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,B
+func TestPrefixedOpCodeA0(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,C
+func TestPrefixedOpCodeA1(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,D
+func TestPrefixedOpCodeA2(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,E
+func TestPrefixedOpCodeA3(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,H
+func TestPrefixedOpCodeA4(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,L
+func TestPrefixedOpCodeA5(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,(HL)
+func TestPrefixedOpCodeA6(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 4,A
+func TestPrefixedOpCodeA7(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,B
+func TestPrefixedOpCodeA8(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,C
+func TestPrefixedOpCodeA9(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,D
+func TestPrefixedOpCodeAA(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,E
+func TestPrefixedOpCodeAB(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,H
+func TestPrefixedOpCodeAC(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,L
+func TestPrefixedOpCodeAD(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,(HL)
+func TestPrefixedOpCodeAE(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 5,A
+func TestPrefixedOpCodeAF(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,B
+func TestPrefixedOpCodeB0(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,C
+func TestPrefixedOpCodeB1(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,D
+func TestPrefixedOpCodeB2(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,E
+func TestPrefixedOpCodeB3(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,H
+func TestPrefixedOpCodeB4(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,L
+func TestPrefixedOpCodeB5(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,(HL)
+func TestPrefixedOpCodeB6(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 6,A
+func TestPrefixedOpCodeB7(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,B
+func TestPrefixedOpCodeB8(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,C
+func TestPrefixedOpCodeB9(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,D
+func TestPrefixedOpCodeBA(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,E
+func TestPrefixedOpCodeBB(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,H
+func TestPrefixedOpCodeBC(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,L
+func TestPrefixedOpCodeBD(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,(HL)
+func TestPrefixedOpCodeBE(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// RES 7,A
+func TestPrefixedOpCodeBF(t *testing.T) {
+	t.Skip("RES not implemented")
+}
+
+// SET 0,B
+func TestPrefixedOpCodeC0(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,C
+func TestPrefixedOpCodeC1(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,D
+func TestPrefixedOpCodeC2(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,E
+func TestPrefixedOpCodeC3(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,H
+func TestPrefixedOpCodeC4(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,L
+func TestPrefixedOpCodeC5(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,(HL)
+func TestPrefixedOpCodeC6(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 0,A
+func TestPrefixedOpCodeC7(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,B
+func TestPrefixedOpCodeC8(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,C
+func TestPrefixedOpCodeC9(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,D
+func TestPrefixedOpCodeCA(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,E
+func TestPrefixedOpCodeCB(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,H
+func TestPrefixedOpCodeCC(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,L
+func TestPrefixedOpCodeCD(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,(HL)
+func TestPrefixedOpCodeCE(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 1,A
+func TestPrefixedOpCodeCF(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,B
+func TestPrefixedOpCodeD0(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,C
+func TestPrefixedOpCodeD1(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,D
+func TestPrefixedOpCodeD2(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,E
+func TestPrefixedOpCodeD3(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,H
+func TestPrefixedOpCodeD4(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,L
+func TestPrefixedOpCodeD5(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,(HL)
+func TestPrefixedOpCodeD6(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 2,A
+func TestPrefixedOpCodeD7(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,B
+func TestPrefixedOpCodeD8(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,C
+func TestPrefixedOpCodeD9(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,D
+func TestPrefixedOpCodeDA(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,E
+func TestPrefixedOpCodeDB(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,H
+func TestPrefixedOpCodeDC(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,L
+func TestPrefixedOpCodeDD(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,(HL)
+func TestPrefixedOpCodeDE(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 3,A
+func TestPrefixedOpCodeDF(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,B
+func TestPrefixedOpCodeE0(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,C
+func TestPrefixedOpCodeE1(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,D
+func TestPrefixedOpCodeE2(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,E
+func TestPrefixedOpCodeE3(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,H
+func TestPrefixedOpCodeE4(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,L
+func TestPrefixedOpCodeE5(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,(HL)
+func TestPrefixedOpCodeE6(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 4,A
+func TestPrefixedOpCodeE7(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,B
+func TestPrefixedOpCodeE8(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,C
+func TestPrefixedOpCodeE9(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,D
+func TestPrefixedOpCodeEA(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,E
+func TestPrefixedOpCodeEB(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,H
+func TestPrefixedOpCodeEC(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,L
+func TestPrefixedOpCodeED(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,(HL)
+func TestPrefixedOpCodeEE(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 5,A
+func TestPrefixedOpCodeEF(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,B
+func TestPrefixedOpCodeF0(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,C
+func TestPrefixedOpCodeF1(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,D
+func TestPrefixedOpCodeF2(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,E
+func TestPrefixedOpCodeF3(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,H
+func TestPrefixedOpCodeF4(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,L
+func TestPrefixedOpCodeF5(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,(HL)
+func TestPrefixedOpCodeF6(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 6,A
+func TestPrefixedOpCodeF7(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,B
+func TestPrefixedOpCodeF8(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,C
+func TestPrefixedOpCodeF9(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,D
+func TestPrefixedOpCodeFA(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,E
+func TestPrefixedOpCodeFB(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,H
+func TestPrefixedOpCodeFC(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,L
+func TestPrefixedOpCodeFD(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,(HL)
+func TestPrefixedOpCodeFE(t *testing.T) {
+	t.Skip("SET not implemented")
+}
+
+// SET 7,A
+func TestPrefixedOpCodeFF(t *testing.T) {
+	t.Skip("SET not implemented")
+}
@@ -1,135 +1,154 @@
 package cpu
 
-import (
-	"errors"
-	"fmt"
-)
+// operand8 reads and writes an 8 bit parameter, it's resolved from the
+// parameter name when the handlers are built instead of at every execution.
+type operand8 struct {
+	read  func(c *CPU) uint8
+	write func(c *CPU, val uint8)
+}
+
+type operand16 struct {
+	read  func(c *CPU) uint16
+	write func(c *CPU, val uint16)
+}
+
+func newOperand8(param string) operand8 {
+	op := operand8{
+		read: func(c *CPU) uint8 {
+			c.fail("cannot read parameter 8 %s", param)
+			return 0
+		},
+		write: func(c *CPU, val uint8) {
+			c.fail("cannot write parameter 8 %s", param)
+		},
+	}
 
-func (c *CPU) ReadParameter(param string) uint8 {
 	switch param {
 	case "A":
-		return c.A
+		op.read = func(c *CPU) uint8 { return c.A }
+		op.write = func(c *CPU, val uint8) { c.A = val }
 	case "B":
-		return c.B
+		op.read = func(c *CPU) uint8 { return c.B }
+		op.write = func(c *CPU, val uint8) { c.B = val }
 	case "C":
-		return c.C
+		op.read = func(c *CPU) uint8 { return c.C }
+		op.write = func(c *CPU, val uint8) { c.C = val }
 	case "D":
-		return c.D
+		op.read = func(c *CPU) uint8 { return c.D }
+		op.write = func(c *CPU, val uint8) { c.D = val }
 	case "E":
-		return c.E
+		op.read = func(c *CPU) uint8 { return c.E }
+		op.write = func(c *CPU, val uint8) { c.E = val }
 	case "H":
-		return c.H
+		op.read = func(c *CPU) uint8 { return c.H }
+		op.write = func(c *CPU, val uint8) { c.H = val }
 	case "L":
-		return c.L
+		op.read = func(c *CPU) uint8 { return c.L }
+		op.write = func(c *CPU, val uint8) { c.L = val }
 	case "(HL)":
-		return c.Hardware.Read(c.ReadHL())
+		op.read = func(c *CPU) uint8 { return c.read(c.ReadHL()) }
+		op.write = func(c *CPU, val uint8) { c.write(c.ReadHL(), val) }
 	case "u8":
-		return c.FetchImmediate8()
+		op.read = func(c *CPU) uint8 { return c.FetchImmediate8() }
+	case "(BC)":
+		op.read = func(c *CPU) uint8 { return c.read(c.ReadBC()) }
+		op.write = func(c *CPU, val uint8) { c.write(c.ReadBC(), val) }
 	case "(DE)":
-		return c.Hardware.Read(c.ReadDE())
+		op.read = func(c *CPU) uint8 { return c.read(c.ReadDE()) }
+		op.write = func(c *CPU, val uint8) { c.write(c.ReadDE(), val) }
 	case "(HL-)":
-		hl := c.ReadHL()
-		res := c.Hardware.Read(hl)
-		c.WriteHL(hl - 1)
-		return res
+		op.read = func(c *CPU) uint8 {
+			hl := c.ReadHL()
+			res := c.read(hl)
+			c.WriteHL(hl - 1)
+			return res
+		}
+		op.write = func(c *CPU, val uint8) {
+			hl := c.ReadHL()
+			c.write(hl, val)
+			c.WriteHL(hl - 1)
+		}
 	case "(HL+)":
-		hl := c.ReadHL()
-		res := c.Hardware.Read(hl)
-		c.WriteHL(hl + 1)
-		return res
+		op.read = func(c *CPU) uint8 {
+			hl := c.ReadHL()
+			res := c.read(hl)
+			c.WriteHL(hl + 1)
+			return res
+		}
+		op.write = func(c *CPU, val uint8) {
+			hl := c.ReadHL()
+			c.write(hl, val)
+			c.WriteHL(hl + 1)
+		}
 	case "(u16)":
-		return c.Hardware.Read(c.FetchImmediate16())
-	case "(BC)":
-		return c.Hardware.Read(c.ReadBC())
+		op.read = func(c *CPU) uint8 { return c.read(c.FetchImmediate16()) }
+		op.write = func(c *CPU, val uint8) { c.write(c.FetchImmediate16(), val) }
 	case "(FF00+u8)":
-		return c.Hardware.Read(0xff00 + uint16(c.FetchImmediate8()))
+		op.read = func(c *CPU) uint8 { return c.read(0xff00 + uint16(c.FetchImmediate8())) }
+		op.write = func(c *CPU, val uint8) { c.write(0xff00+uint16(c.FetchImmediate8()), val) }
 	case "(FF00+C)":
-		return c.Hardware.Read(0xff00 + uint16(c.C))
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: cannot read parameter 8 %s", param)))
+		op.read = func(c *CPU) uint8 { return c.read(0xff00 + uint16(c.C)) }
+		op.write = func(c *CPU, val uint8) { c.write(0xff00+uint16(c.C), val) }
 	}
+
+	return op
 }
 
-func (c *CPU) WriteParameter(param string, val uint8) {
-	switch param {
-	case "A":
-		c.A = val
-	case "B":
-		c.B = val
-	case "C":
-		c.C = val
-	case "D":
-		c.D = val
-	case "E":
-		c.E = val
-	case "H":
-		c.H = val
-	case "L":
-		c.L = val
-	case "(HL)":
-		c.Hardware.Write(c.ReadHL(), val)
-	case "(HL-)":
-		hl := c.ReadHL()
-		c.Hardware.Write(hl, val)
-		c.WriteHL(hl - 1)
-	case "(HL+)":
-		hl := c.ReadHL()
-		c.Hardware.Write(hl, val)
-		c.WriteHL(hl + 1)
-	case "(FF00+C)":
-		c.Hardware.Write(uint16(0xff00)+uint16(c.C), val)
-	case "(FF00+u8)":
-		c.Hardware.Write(uint16(0xff00)+uint16(c.FetchImmediate8()), val)
-	case "(BC)":
-		c.Hardware.Write(c.ReadBC(), val)
-	case "(u16)":
-		u16 := c.FetchImmediate16()
-		c.Hardware.Write(u16, val)
-	case "(DE)":
-		c.Hardware.Write(c.ReadDE(), val)
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: cannot write parameter 8 %s", param)))
+func newOperand16(param string) operand16 {
+	op := operand16{
+		read: func(c *CPU) uint16 {
+			c.fail("cannot read parameter 16 %s", param)
+			return 0
+		},
+		write: func(c *CPU, val uint16) {
+			c.fail("cannot write parameter 16 %s", param)
+		},
 	}
-}
 
-func (c *CPU) ReadParameter16(param string) uint16 {
 	switch param {
 	case "AF":
-		return c.ReadAF()
+		op.read = (*CPU).ReadAF
+		op.write = (*CPU).WriteAF
 	case "BC":
-		return c.ReadBC()
+		op.read = (*CPU).ReadBC
+		op.write = (*CPU).WriteBC
 	case "DE":
-		return c.ReadDE()
+		op.read = (*CPU).ReadDE
+		op.write = (*CPU).WriteDE
 	case "HL":
-		return c.ReadHL()
+		op.read = (*CPU).ReadHL
+		op.write = (*CPU).WriteHL
 	case "SP":
-		return c.SP
+		op.read = func(c *CPU) uint16 { return c.SP }
+		op.write = func(c *CPU, val uint16) { c.SP = val }
 	case "u16":
-		return c.FetchImmediate16()
+		op.read = (*CPU).FetchImmediate16
 	case "SP+i8":
-		return c.SP + uint16(int8(c.FetchImmediate8()))
+		op.read = func(c *CPU) uint16 { return c.SP + uint16(int8(c.FetchImmediate8())) }
 	case "i8":
-		return uint16(int8(c.FetchImmediate8()))
-	default:
-		panic(errors.New(fmt.Sprintf("cpu error: cannot read parameter 16 %s", param)))
+		op.read = func(c *CPU) uint16 { return uint16(int8(c.FetchImmediate8())) }
+	case "(u16)":
+		op.write = func(c *CPU, val uint16) { c.write16(c.FetchImmediate16(), val) }
 	}
+
+	return op
 }
 
-func (c *CPU) WriteParameter16(param string, val uint16) {
-	switch param {
-	case "AF":
-		c.WriteAF(val)
-	case "BC":
-		c.WriteBC(val)
-	case "DE":
-		c.WriteDE(val)
-	case "HL":
-		c.WriteHL(val)
-	case "SP":
-		c.SP = val
-	case "(u16)":
-		c.Hardware.Write16(c.FetchImmediate16(), val)
+// newCondition returns the test of a condition parameter.
+func newCondition(cond string) func(c *CPU) bool {
+	switch cond {
+	case "NZ":
+		return func(c *CPU) bool { return !c.F.Zero }
+	case "Z":
+		return func(c *CPU) bool { return c.F.Zero }
+	case "NC":
+		return func(c *CPU) bool { return !c.F.Carry }
+	case "C":
+		return func(c *CPU) bool { return c.F.Carry }
 	default:
-		panic(errors.New(fmt.Sprintf("cpu error: cannot write parameter 16 %s", param)))
+		return func(c *CPU) bool {
+			c.fail("invalid condition %s", cond)
+			return false
+		}
 	}
 }
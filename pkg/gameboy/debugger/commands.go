@@ -0,0 +1,508 @@
+package debugger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/cpu"
+	"github.com/adnsio/gbemu/pkg/gameboy/disasm"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware"
+)
+
+type command struct {
+	names []string
+	args  string
+	help  string
+	quit  bool
+	run   func(d *Debugger, args []string) error
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{names: []string{"help", "h"}, help: "print this help", run: cmdHelp},
+		{names: []string{"break", "b"}, args: "[bank:]addr|symbol", help: "add a breakpoint", run: cmdBreak},
+		{names: []string{"delete", "d"}, args: "[n]", help: "delete breakpoint n, all without n", run: cmdDelete},
+		{names: []string{"breakpoints", "bl"}, help: "list the breakpoints", run: cmdBreakpoints},
+		{names: []string{"watch", "wp"}, args: "r|w|x|rw... addr[-end] [val]", help: "add a watchpoint on reads, writes or execution, of val only if given", run: cmdWatch},
+		{names: []string{"unwatch", "uw"}, args: "[n]", help: "delete watchpoint n, all without n", run: cmdUnwatch},
+		{names: []string{"watchpoints", "wl"}, help: "list the watchpoints", run: cmdWatchpoints},
+		{names: []string{"step", "s"}, args: "[n]", help: "execute n instructions", run: cmdStep},
+		{names: []string{"next", "n"}, help: "execute an instruction, stepping over CALL and RST", run: cmdNext},
+		{names: []string{"finish", "out"}, help: "run until the current function returns", run: cmdFinish},
+		{names: []string{"continue", "c"}, help: "run until a breakpoint or an interrupt (ctrl-c)", run: cmdContinue},
+		{names: []string{"frame", "f"}, args: "[n]", help: "run until frame n, the next one without n", run: cmdFrame},
+		{names: []string{"regs", "r"}, help: "print the registers", run: cmdRegs},
+		{names: []string{"set"}, args: "reg|flag val", help: "set a register (a, f, b, ..., af, bc, de, hl, sp, pc) or a flag (zf, nf, hf, cf)", run: cmdSet},
+		{names: []string{"mem", "x"}, args: "addr [len]", help: "dump len bytes of memory", run: cmdMem},
+		{names: []string{"write", "w"}, args: "addr val...", help: "write bytes to memory", run: cmdWrite},
+		{names: []string{"dis", "l"}, args: "[addr] [n]", help: "disassemble n instructions, around PC without addr", run: cmdDis},
+		{names: []string{"quit", "q"}, help: "exit the debugger", quit: true},
+	}
+}
+
+// optionalInt parses the optional decimal argument i.
+func optionalInt(args []string, i int, def int) (int, error) {
+	if len(args) <= i {
+		return def, nil
+	}
+
+	res, err := strconv.Atoi(args[i])
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", args[i])
+	}
+
+	return res, nil
+}
+
+func cmdHelp(d *Debugger, args []string) error {
+	for _, c := range commands {
+		usage := strings.Join(c.names, ", ")
+		if c.args != "" {
+			usage += " " + c.args
+		}
+
+		fmt.Fprintf(d.out, "  %-28s %s\n", usage, c.help)
+	}
+
+	fmt.Fprintln(d.out, "addresses and values are hexadecimal, counts are decimal, symbols can be used as addresses")
+
+	return nil
+}
+
+func cmdBreak(d *Debugger, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: break [bank:]addr|symbol")
+	}
+
+	loc, err := d.parseLocation(args[0])
+	if err != nil {
+		return err
+	}
+
+	d.Breakpoints = append(d.Breakpoints, loc)
+	fmt.Fprintf(d.out, "breakpoint %d at %s%s\n", len(d.Breakpoints)-1, loc, d.symbolSuffix(loc.Bank, loc.Addr))
+
+	return nil
+}
+
+func cmdDelete(d *Debugger, args []string) error {
+	if len(args) == 0 {
+		d.Breakpoints = nil
+		return nil
+	}
+
+	i, err := optionalInt(args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	if i < 0 || i >= len(d.Breakpoints) {
+		return fmt.Errorf("no breakpoint %d", i)
+	}
+
+	d.Breakpoints = append(d.Breakpoints[:i], d.Breakpoints[i+1:]...)
+
+	return nil
+}
+
+func cmdBreakpoints(d *Debugger, args []string) error {
+	for i, bp := range d.Breakpoints {
+		fmt.Fprintf(d.out, "%d  %s%s\n", i, bp, d.symbolSuffix(bp.Bank, bp.Addr))
+	}
+
+	return nil
+}
+
+func parseAccess(s string) (hardware.Access, error) {
+	var res hardware.Access
+
+	for _, c := range s {
+		switch c {
+		case 'r':
+			res |= hardware.AccessRead
+		case 'w':
+			res |= hardware.AccessWrite
+		case 'x':
+			res |= hardware.AccessExecute
+		default:
+			return 0, fmt.Errorf("invalid access %q", s)
+		}
+	}
+
+	return res, nil
+}
+
+func formatAccess(a hardware.Access) string {
+	res := ""
+
+	for i, c := range "rwx" {
+		if a&(1<<uint(i)) != 0 {
+			res += string(c)
+		}
+	}
+
+	return res
+}
+
+func cmdWatch(d *Debugger, args []string) error {
+	if len(args) < 2 || len(args) > 3 {
+		return errors.New("usage: watch r|w|x|rw... addr[-end] [val]")
+	}
+
+	access, err := parseAccess(args[0])
+	if err != nil {
+		return err
+	}
+
+	wp := hardware.Watchpoint{Access: access}
+
+	bounds := strings.SplitN(args[1], "-", 2)
+	for i, bound := range bounds {
+		loc, err := d.parseLocation(bound)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			wp.Start = loc.Addr
+		}
+		wp.End = loc.Addr
+	}
+
+	if len(args) == 3 {
+		val, err := parseHex(args[2], 8)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", args[2])
+		}
+
+		wp.HasValue = true
+		wp.Value = uint8(val)
+	}
+
+	id := d.Watch(wp)
+	fmt.Fprintf(d.out, "watchpoint %d: %s\n", id, formatWatchpoint(wp))
+
+	return nil
+}
+
+func formatWatchpoint(wp hardware.Watchpoint) string {
+	res := fmt.Sprintf("%s %04X", formatAccess(wp.Access), wp.Start)
+
+	if wp.End != wp.Start {
+		res += fmt.Sprintf("-%04X", wp.End)
+	}
+
+	if wp.HasValue {
+		res += fmt.Sprintf(" = %02X", wp.Value)
+	}
+
+	return res
+}
+
+func cmdUnwatch(d *Debugger, args []string) error {
+	if len(args) == 0 {
+		for id := range d.Watchpoints {
+			d.Unwatch(id)
+		}
+
+		return nil
+	}
+
+	id, err := optionalInt(args, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := d.Watchpoints[id]; !ok {
+		return fmt.Errorf("no watchpoint %d", id)
+	}
+
+	d.Unwatch(id)
+
+	return nil
+}
+
+func cmdWatchpoints(d *Debugger, args []string) error {
+	ids := make([]int, 0, len(d.Watchpoints))
+	for id := range d.Watchpoints {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		fmt.Fprintf(d.out, "%d  %s\n", id, formatWatchpoint(d.Watchpoints[id]))
+	}
+
+	return nil
+}
+
+func cmdStep(d *Debugger, args []string) error {
+	n, err := optionalInt(args, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	return d.run(func(inst *cpu.Instruction) bool {
+		n--
+		return n <= 0
+	})
+}
+
+func cmdNext(d *Debugger, args []string) error {
+	c := d.GameBoy.CPU
+	inst := disasm.Decode(d.GameBoy.Hardware.Peek, c.PC)
+
+	if c.IsNextInstructionPrefixed || inst.Flow != disasm.FlowCall {
+		return cmdStep(d, nil)
+	}
+
+	ret := c.PC + uint16(inst.Length())
+	sp := c.SP
+
+	return d.run(func(inst *cpu.Instruction) bool {
+		return c.PC == ret && c.SP >= sp
+	})
+}
+
+func cmdFinish(d *Debugger, args []string) error {
+	c := d.GameBoy.CPU
+	sp := c.SP
+
+	return d.run(func(inst *cpu.Instruction) bool {
+		return (inst.Name == "RET" || inst.Name == "RETI") && c.SP > sp
+	})
+}
+
+func cmdContinue(d *Debugger, args []string) error {
+	return d.run(func(inst *cpu.Instruction) bool {
+		return false
+	})
+}
+
+func cmdFrame(d *Debugger, args []string) error {
+	frame, err := optionalInt(args, 0, d.GameBoy.Frame+1)
+	if err != nil {
+		return err
+	}
+
+	if frame <= d.GameBoy.Frame {
+		return fmt.Errorf("already at frame %d", d.GameBoy.Frame)
+	}
+
+	return d.run(func(inst *cpu.Instruction) bool {
+		return d.GameBoy.Frame >= frame
+	})
+}
+
+func flagsString(f *cpu.CPUFlags) string {
+	res := []byte("----")
+
+	if f.Zero {
+		res[0] = 'Z'
+	}
+
+	if f.Subtract {
+		res[1] = 'N'
+	}
+
+	if f.HalfCarry {
+		res[2] = 'H'
+	}
+
+	if f.Carry {
+		res[3] = 'C'
+	}
+
+	return string(res)
+}
+
+func cmdRegs(d *Debugger, args []string) error {
+	c := d.GameBoy.CPU
+
+	fmt.Fprintf(d.out, "A:%02X F:%s BC:%04X DE:%04X HL:%04X SP:%04X PC:%s\n", c.A, flagsString(c.F), c.ReadBC(), c.ReadDE(), c.ReadHL(), c.SP, d.Location())
+	fmt.Fprintf(d.out, "frame %d, cycle %d\n", d.GameBoy.Frame, d.GameBoy.FrameCycles)
+
+	return nil
+}
+
+func cmdSet(d *Debugger, args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: set reg|flag val")
+	}
+
+	c := d.GameBoy.CPU
+	name := strings.ToLower(args[0])
+
+	val, err := parseHex(args[1], 16)
+	if err != nil {
+		return fmt.Errorf("invalid value %q", args[1])
+	}
+
+	regs8 := map[string]*uint8{"a": &c.A, "b": &c.B, "c": &c.C, "d": &c.D, "e": &c.E, "h": &c.H, "l": &c.L}
+	flags := map[string]*bool{"zf": &c.F.Zero, "nf": &c.F.Subtract, "hf": &c.F.HalfCarry, "cf": &c.F.Carry}
+
+	if flag, ok := flags[name]; ok {
+		*flag = val != 0
+		return nil
+	}
+
+	if reg, ok := regs8[name]; ok {
+		if val > 0xff {
+			return fmt.Errorf("value %#x too large for %s", val, name)
+		}
+
+		*reg = uint8(val)
+		return nil
+	}
+
+	switch name {
+	case "f":
+		c.F.Write(uint8(val))
+	case "af":
+		c.WriteAF(uint16(val))
+	case "bc":
+		c.WriteBC(uint16(val))
+	case "de":
+		c.WriteDE(uint16(val))
+	case "hl":
+		c.WriteHL(uint16(val))
+	case "sp":
+		c.SP = uint16(val)
+	case "pc":
+		c.PC = uint16(val)
+	default:
+		return fmt.Errorf("unknown register %q", args[0])
+	}
+
+	return nil
+}
+
+func cmdMem(d *Debugger, args []string) error {
+	if len(args) < 1 {
+		return errors.New("usage: mem addr [len]")
+	}
+
+	loc, err := d.parseLocation(args[0])
+	if err != nil {
+		return err
+	}
+
+	size, err := optionalInt(args, 1, 0x40)
+	if err != nil {
+		return err
+	}
+
+	for line := 0; line < size; line += 16 {
+		addr := loc.Addr + uint16(line)
+		hex := make([]string, 0, 16)
+		text := make([]byte, 0, 16)
+
+		for i := 0; i < 16 && line+i < size; i++ {
+			val := d.GameBoy.Hardware.Peek(addr + uint16(i))
+			hex = append(hex, fmt.Sprintf("%02X", val))
+
+			if val >= 0x20 && val < 0x7f {
+				text = append(text, val)
+			} else {
+				text = append(text, '.')
+			}
+		}
+
+		fmt.Fprintf(d.out, "%04X  %-47s  %s\n", addr, strings.Join(hex, " "), text)
+	}
+
+	return nil
+}
+
+func cmdWrite(d *Debugger, args []string) error {
+	if len(args) < 2 {
+		return errors.New("usage: write addr val...")
+	}
+
+	loc, err := d.parseLocation(args[0])
+	if err != nil {
+		return err
+	}
+
+	vals := make([]uint8, len(args)-1)
+	for i, arg := range args[1:] {
+		val, err := parseHex(arg, 8)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", arg)
+		}
+
+		vals[i] = uint8(val)
+	}
+
+	for i, val := range vals {
+		d.GameBoy.Hardware.Write(loc.Addr+uint16(i), val)
+	}
+
+	return nil
+}
+
+func cmdDis(d *Debugger, args []string) error {
+	pc := d.GameBoy.CPU.PC
+	addr := pc
+
+	if len(args) > 0 {
+		loc, err := d.parseLocation(args[0])
+		if err != nil {
+			return err
+		}
+
+		addr = loc.Addr
+	}
+
+	n, err := optionalInt(args, 1, 8)
+	if err != nil {
+		return err
+	}
+
+	// the instructions before PC can't be found going backwards, the last
+	// executed ones are shown instead
+	if len(args) == 0 {
+		start := d.historyLen - historySize
+		if start < 0 {
+			start = 0
+		}
+
+		for i := start; i < d.historyLen; i++ {
+			d.printInstruction(d.history[i%historySize], false)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		addr += uint16(d.printInstruction(addr, addr == pc))
+	}
+
+	return nil
+}
+
+func (d *Debugger) printInstruction(addr uint16, current bool) int {
+	inst := disasm.Decode(d.GameBoy.Hardware.Peek, addr)
+
+	bytes := make([]string, inst.Length())
+	for i, val := range inst.Bytes {
+		bytes[i] = fmt.Sprintf("%02X", val)
+	}
+
+	marker := " "
+	if current {
+		marker = ">"
+	}
+
+	bank := d.GameBoy.Hardware.Bank(addr)
+	if name, ok := d.Symbols.Name(bank, addr); ok {
+		fmt.Fprintf(d.out, "%s:\n", name)
+	}
+
+	fmt.Fprintf(d.out, "%s %02X:%04X  %-8s  %s\n", marker, bank, addr, strings.Join(bytes, " "), inst.Format(d.symbolName))
+
+	return inst.Length()
+}
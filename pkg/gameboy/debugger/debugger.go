@@ -0,0 +1,323 @@
+package debugger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/cpu"
+	"github.com/adnsio/gbemu/pkg/gameboy/disasm"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+const (
+	Prompt = "(debug) "
+
+	// AnyBank matches an address in every rom bank
+	AnyBank = -1
+
+	historySize = 4
+)
+
+// Location is an address, optionally qualified by its rom bank.
+type Location struct {
+	Bank int
+	Addr uint16
+}
+
+func (l Location) String() string {
+	if l.Bank == AnyBank {
+		return fmt.Sprintf("%04X", l.Addr)
+	}
+
+	return fmt.Sprintf("%02X:%04X", l.Bank, l.Addr)
+}
+
+// ParseLocation parses a hexadecimal address like 4A2F, $4A2F or 0x4A2F,
+// optionally prefixed by the bank as in 03:4A2F.
+func ParseLocation(s string) (Location, error) {
+	loc := Location{Bank: AnyBank}
+
+	if i := strings.Index(s, ":"); i >= 0 {
+		bank, err := parseHex(s[:i], 8)
+		if err != nil {
+			return loc, fmt.Errorf("invalid bank %q", s[:i])
+		}
+
+		loc.Bank = int(bank)
+		s = s[i+1:]
+	}
+
+	addr, err := parseHex(s, 16)
+	if err != nil {
+		return loc, fmt.Errorf("invalid address %q", s)
+	}
+
+	loc.Addr = uint16(addr)
+
+	return loc, nil
+}
+
+// parseLocation parses a location or a symbol, optionally followed by a
+// hexadecimal offset as in Main+1A.
+func (d *Debugger) parseLocation(s string) (Location, error) {
+	name, offset := s, uint64(0)
+
+	if i := strings.LastIndex(s, "+"); i >= 0 {
+		var err error
+
+		name = s[:i]
+		offset, err = parseHex(s[i+1:], 16)
+		if err != nil {
+			return Location{}, fmt.Errorf("invalid offset %q", s[i+1:])
+		}
+	}
+
+	if sym, ok := d.Symbols.Lookup(name); ok {
+		return Location{Bank: sym.Bank, Addr: sym.Addr + uint16(offset)}, nil
+	}
+
+	return ParseLocation(s)
+}
+
+// symbolName returns addr as label+offset, in the bank currently mapped.
+func (d *Debugger) symbolName(addr uint16) string {
+	return d.Symbols.Format(d.GameBoy.Hardware.Bank(addr), addr)
+}
+
+// symbolSuffix returns the symbol of an address to append to it.
+func (d *Debugger) symbolSuffix(bank int, addr uint16) string {
+	if bank == AnyBank {
+		bank = d.GameBoy.Hardware.Bank(addr)
+	}
+
+	if name := d.Symbols.Format(bank, addr); name != "" {
+		return " (" + name + ")"
+	}
+
+	return ""
+}
+
+func parseHex(s string, bits int) (uint64, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(s), "$"), "0x")
+
+	return strconv.ParseUint(s, 16, bits)
+}
+
+// Debugger is a command line debugger controlling a game boy, the commands
+// are listed by help.
+type Debugger struct {
+	GameBoy     *gameboy.GameBoy
+	Breakpoints []Location
+	// Watchpoints are indexed by their hardware id
+	Watchpoints map[int]hardware.Watchpoint
+	// Symbols are shown next to the addresses and accepted in their place
+	Symbols *symbols.Table
+
+	hits        []hardware.WatchHit
+	in          *bufio.Scanner
+	out         io.Writer
+	history     [historySize]uint16
+	historyLen  int
+	interrupted int32
+}
+
+func NewDebugger(gb *gameboy.GameBoy, in io.Reader, out io.Writer) *Debugger {
+	return &Debugger{
+		GameBoy:     gb,
+		Watchpoints: make(map[int]hardware.Watchpoint),
+		in:          bufio.NewScanner(in),
+		out:         out,
+	}
+}
+
+// Interrupt stops a running command, it's safe to call from other goroutines.
+func (d *Debugger) Interrupt() {
+	atomic.StoreInt32(&d.interrupted, 1)
+}
+
+// Run reads and executes commands until quit or the end of the input.
+func (d *Debugger) Run() {
+	d.printLocation()
+
+	for {
+		fmt.Fprint(d.out, Prompt)
+
+		if !d.in.Scan() {
+			fmt.Fprintln(d.out)
+			return
+		}
+
+		if quit := d.Execute(d.in.Text()); quit {
+			return
+		}
+	}
+}
+
+// Execute runs a single command line, it returns true when the debugger
+// should quit.
+func (d *Debugger) Execute(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+
+	cmd, args := fields[0], fields[1:]
+
+	for _, c := range commands {
+		for _, name := range c.names {
+			if name != cmd {
+				continue
+			}
+
+			if c.quit {
+				return true
+			}
+
+			if err := c.run(d, args); err != nil {
+				fmt.Fprintf(d.out, "error: %v\n", err)
+			}
+
+			return false
+		}
+	}
+
+	fmt.Fprintf(d.out, "error: unknown command %q, try help\n", cmd)
+
+	return false
+}
+
+// Location returns the location of the current instruction.
+func (d *Debugger) Location() Location {
+	pc := d.GameBoy.CPU.PC
+
+	return Location{
+		Bank: d.GameBoy.Hardware.Bank(pc),
+		Addr: pc,
+	}
+}
+
+func (d *Debugger) breakpointAt(loc Location) int {
+	for i, bp := range d.Breakpoints {
+		if bp.Addr == loc.Addr && (bp.Bank == AnyBank || bp.Bank == loc.Bank) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Watch adds a watchpoint stopping the execution when hit.
+func (d *Debugger) Watch(wp hardware.Watchpoint) int {
+	id := d.GameBoy.Hardware.Watch(wp, func(hit hardware.WatchHit) {
+		d.hits = append(d.hits, hit)
+	})
+
+	d.Watchpoints[id] = wp
+
+	return id
+}
+
+func (d *Debugger) Unwatch(id int) {
+	d.GameBoy.Hardware.Unwatch(id)
+	delete(d.Watchpoints, id)
+}
+
+// nextInstruction returns the instruction at PC.
+func (d *Debugger) nextInstruction() *cpu.Instruction {
+	c := d.GameBoy.CPU
+	opCode := d.GameBoy.Hardware.Peek(c.PC)
+
+	if c.IsNextInstructionPrefixed {
+		return cpu.PrefixedInstructions[opCode]
+	}
+
+	if opCode == 0xcb {
+		return cpu.PrefixedInstructions[d.GameBoy.Hardware.Peek(c.PC+1)]
+	}
+
+	return cpu.Instructions[opCode]
+}
+
+// step executes a whole instruction, prefix included, and returns it.
+func (d *Debugger) step() (inst *cpu.Instruction, err error) {
+	// a bug in the core must not end the debugging session
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	d.history[d.historyLen%historySize] = d.GameBoy.CPU.PC
+	d.historyLen++
+
+	for {
+		inst = d.nextInstruction()
+
+		if _, err := d.GameBoy.Step(); err != nil {
+			return inst, err
+		}
+
+		if !d.GameBoy.CPU.IsNextInstructionPrefixed {
+			return inst, nil
+		}
+	}
+}
+
+// run executes instructions until stop returns true, a breakpoint or a
+// watchpoint is hit or the debugger is interrupted. The breakpoint on the
+// first instruction is ignored, so running from a breakpoint doesn't stop
+// immediately.
+func (d *Debugger) run(stop func(inst *cpu.Instruction) bool) error {
+	atomic.StoreInt32(&d.interrupted, 0)
+	d.hits = nil
+
+	for first := true; ; first = false {
+		if !first {
+			if i := d.breakpointAt(d.Location()); i >= 0 {
+				fmt.Fprintf(d.out, "breakpoint %d at %s\n", i, d.Location())
+				break
+			}
+
+			if atomic.LoadInt32(&d.interrupted) != 0 {
+				fmt.Fprintln(d.out, "interrupted")
+				break
+			}
+		}
+
+		inst, err := d.step()
+		if err != nil {
+			d.printLocation()
+			return err
+		}
+
+		if d.hits != nil {
+			for _, hit := range d.hits {
+				fmt.Fprintf(d.out, "watchpoint %d: %s%s\n", hit.ID, hit, d.symbolSuffix(hit.Bank, hit.PC))
+			}
+
+			d.hits = nil
+			break
+		}
+
+		if stop(inst) {
+			break
+		}
+	}
+
+	d.printLocation()
+
+	return nil
+}
+
+func (d *Debugger) printLocation() {
+	loc := d.Location()
+	inst := disasm.Decode(d.GameBoy.Hardware.Peek, loc.Addr)
+
+	fmt.Fprintf(d.out, "%s%s  %s\n", loc, d.symbolSuffix(loc.Bank, loc.Addr), inst.Format(d.symbolName))
+}
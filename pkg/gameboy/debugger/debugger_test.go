@@ -0,0 +1,186 @@
+package debugger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+// newTestDebugger runs a program calling a function incrementing B from a
+// loop incrementing A:
+//
+//	0100 CALL $0200
+//	0103 INC A
+//	0104 JR $0100
+//	0200 INC B
+//	0201 RET
+func newTestDebugger(t *testing.T) (*Debugger, *bytes.Buffer) {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0xcd, 0x00, 0x02, 0x3c, 0x18, 0xfa})
+	copy(rom[0x0200:], []uint8{0x04, 0xc9})
+
+	out := &bytes.Buffer{}
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewDebugger(gb, strings.NewReader(""), out), out
+}
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		text string
+		want Location
+	}{
+		{"4a2f", Location{Bank: AnyBank, Addr: 0x4a2f}},
+		{"$4A2F", Location{Bank: AnyBank, Addr: 0x4a2f}},
+		{"0x0150", Location{Bank: AnyBank, Addr: 0x0150}},
+		{"03:4A2F", Location{Bank: 3, Addr: 0x4a2f}},
+	}
+
+	for _, test := range tests {
+		got, err := ParseLocation(test.text)
+		if err != nil || got != test.want {
+			t.Errorf("parse %q error: want %v, got %v (%v)", test.text, test.want, got, err)
+		}
+	}
+
+	if _, err := ParseLocation("1:10000"); err == nil {
+		t.Error("parse 1:10000 error: want error, got nil")
+	}
+}
+
+func TestDebugger_Stepping(t *testing.T) {
+	d, _ := newTestDebugger(t)
+	c := d.GameBoy.CPU
+
+	d.Execute("next")
+	if c.PC != 0x0103 || c.B != 1 {
+		t.Fatalf("next error: want PC 0x0103 B 1, got PC %#04x B %d", c.PC, c.B)
+	}
+
+	d.Execute("step 2")
+	if c.PC != 0x0100 || c.A != 0x02 {
+		t.Fatalf("step error: want PC 0x0100 A 0x02, got PC %#04x A %#02x", c.PC, c.A)
+	}
+
+	d.Execute("step")
+	if c.PC != 0x0200 {
+		t.Fatalf("step into error: want PC 0x0200, got %#04x", c.PC)
+	}
+
+	d.Execute("finish")
+	if c.PC != 0x0103 || c.B != 2 {
+		t.Fatalf("finish error: want PC 0x0103 B 2, got PC %#04x B %d", c.PC, c.B)
+	}
+}
+
+func TestDebugger_Breakpoints(t *testing.T) {
+	d, out := newTestDebugger(t)
+	c := d.GameBoy.CPU
+
+	d.Execute("break 00:0201")
+	d.Execute("continue")
+	if c.PC != 0x0201 {
+		t.Fatalf("continue error: want PC 0x0201, got %#04x", c.PC)
+	}
+
+	// the breakpoint on the current instruction doesn't stop continue
+	d.Execute("continue")
+	if c.PC != 0x0201 || c.B != 2 {
+		t.Fatalf("continue error: want PC 0x0201 B 2, got PC %#04x B %d", c.PC, c.B)
+	}
+
+	// 0201 is not in the switchable bank 1
+	d.Execute("delete")
+	d.Execute("break 01:0201")
+	d.Execute("frame")
+	if d.GameBoy.Frame != 1 {
+		t.Fatalf("frame error: want frame 1, got %d", d.GameBoy.Frame)
+	}
+
+	if !strings.Contains(out.String(), "breakpoint 0 at 00:0201") {
+		t.Errorf("output error: missing breakpoint in %q", out.String())
+	}
+}
+
+func TestDebugger_Memory(t *testing.T) {
+	d, out := newTestDebugger(t)
+	c := d.GameBoy.CPU
+
+	d.Execute("write c000 12 34")
+	d.Execute("mem c000 2")
+	if got := out.String(); !strings.HasPrefix(got, "C000  12 34") {
+		t.Errorf("mem error: want C000  12 34, got %q", got)
+	}
+
+	d.Execute("set a 42")
+	d.Execute("set hl c000")
+	d.Execute("set cf 1")
+	if c.A != 0x42 || c.ReadHL() != 0xc000 || !c.F.Carry {
+		t.Errorf("set error: want A 0x42 HL 0xc000 carry, got A %#02x HL %#04x carry %t", c.A, c.ReadHL(), c.F.Carry)
+	}
+
+	out.Reset()
+	d.Execute("dis 0100 2")
+	if got := out.String(); !strings.Contains(got, "call $0200") || !strings.Contains(got, "inc a") {
+		t.Errorf("dis error: got %q", got)
+	}
+}
+
+func TestDebugger_Watchpoints(t *testing.T) {
+	d, out := newTestDebugger(t)
+	c := d.GameBoy.CPU
+
+	// the return address is pushed by the call
+	d.Execute("watch w fffc-fffd")
+	d.Execute("continue")
+	if c.PC != 0x0200 {
+		t.Fatalf("continue error: want PC 0x0200, got %#04x", c.PC)
+	}
+
+	if !strings.Contains(out.String(), "watchpoint 1: write FFFC = 03 by 00:0100") {
+		t.Errorf("output error: missing watchpoint in %q", out.String())
+	}
+
+	d.Execute("unwatch")
+	d.Execute("watch x 0103")
+	d.Execute("continue")
+	if c.PC != 0x0104 {
+		t.Errorf("continue error: want PC 0x0104, got %#04x", c.PC)
+	}
+}
+
+func TestDebugger_Symbols(t *testing.T) {
+	d, out := newTestDebugger(t)
+	c := d.GameBoy.CPU
+
+	syms, err := symbols.Parse(strings.NewReader("00:0100 Main\n00:0200 IncB\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Symbols = syms
+
+	d.Execute("break IncB+1")
+	d.Execute("continue")
+	if c.PC != 0x0201 {
+		t.Fatalf("continue error: want PC 0x0201, got %#04x", c.PC)
+	}
+
+	got := out.String()
+	for _, want := range []string{"breakpoint 0 at 00:0201 (IncB+1)", "00:0201 (IncB+1)  ret"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output error: missing %q in %q", want, got)
+		}
+	}
+
+	out.Reset()
+	d.Execute("dis Main 1")
+	if got := out.String(); got != "Main:\n  00:0100  CD 00 02  call IncB\n" {
+		t.Errorf("dis error: got %q", got)
+	}
+}
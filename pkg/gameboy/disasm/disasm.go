@@ -0,0 +1,239 @@
+package disasm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/cpu"
+)
+
+// Flow is how an instruction affects the execution of the next ones.
+type Flow int
+
+const (
+	// FlowNext continues with the next instruction
+	FlowNext Flow = iota
+	// FlowBranch is a conditional jump, continuing with the next instruction or the target
+	FlowBranch
+	// FlowCall calls the target, continuing with the next instruction when it returns
+	FlowCall
+	// FlowJump continues with the target
+	FlowJump
+	// FlowIndirect continues at an address known only at run time
+	FlowIndirect
+	// FlowReturn continues with the caller
+	FlowReturn
+	// FlowStop doesn't continue, as for invalid opcodes
+	FlowStop
+)
+
+// Ends returns true if the instruction after this one isn't executed next.
+func (f Flow) Ends() bool {
+	return f >= FlowJump
+}
+
+// Instruction is a decoded instruction, with the operands in RGBDS syntax.
+type Instruction struct {
+	Addr           uint16
+	Bytes          []uint8
+	Mnemonic       string
+	Operands       []string
+	CyclesBranch   int
+	CyclesNoBranch int
+	Flow           Flow
+	// Target is the address jumped to by jumps, calls and restarts
+	Target    uint16
+	HasTarget bool
+
+	targetOperand int
+}
+
+func (inst *Instruction) Length() int {
+	return len(inst.Bytes)
+}
+
+func (inst *Instruction) String() string {
+	return inst.Format(nil)
+}
+
+// Format returns the instruction text with the target replaced by the label
+// returned by label, if not empty.
+func (inst *Instruction) Format(label func(addr uint16) string) string {
+	operands := inst.Operands
+
+	if inst.HasTarget && label != nil {
+		if name := label(inst.Target); name != "" {
+			operands = append([]string(nil), operands...)
+			operands[inst.targetOperand] = name
+		}
+	}
+
+	if len(operands) == 0 {
+		return inst.Mnemonic
+	}
+
+	return inst.Mnemonic + " " + strings.Join(operands, ", ")
+}
+
+// Decode decodes the instruction at addr, reading memory with read.
+func Decode(read func(addr uint16) uint8, addr uint16) *Instruction {
+	opCode := read(addr)
+	info := cpu.Instructions[opCode]
+
+	res := &Instruction{
+		Addr:           addr,
+		Bytes:          []uint8{opCode},
+		Mnemonic:       strings.ToLower(info.Name),
+		CyclesBranch:   info.CyclesBranch,
+		CyclesNoBranch: info.CyclesNoBranch,
+		targetOperand:  -1,
+	}
+
+	switch info.Name {
+	case "PREFIX":
+		info = cpu.PrefixedInstructions[read(addr+1)]
+		res.Bytes = append(res.Bytes, read(addr+1))
+		res.Mnemonic = strings.ToLower(info.Name)
+		res.CyclesBranch = info.CyclesBranch
+		res.CyclesNoBranch = info.CyclesNoBranch
+	case "UNUSED":
+		res.Mnemonic = "db"
+		res.Operands = []string{fmt.Sprintf("$%02X", opCode)}
+		res.Flow = FlowStop
+
+		return res
+	case "STOP":
+		// stop is followed by a byte, usually 0, ignored by the cpu
+		res.Bytes = append(res.Bytes, read(addr+1))
+		if res.Bytes[1] != 0 {
+			res.Operands = []string{fmt.Sprintf("$%02X", res.Bytes[1])}
+		}
+	}
+
+	for i, param := range info.Parameters {
+		operand := decodeOperand(read, res, param)
+
+		switch param {
+		case "u16", "i8":
+			if info.Name == "JP" || info.Name == "JR" || info.Name == "CALL" {
+				res.targetOperand = i
+			}
+		case "00h", "08h", "10h", "18h", "20h", "28h", "30h", "38h":
+			res.targetOperand = i
+		}
+
+		// in RGBDS the loads from the high page have their own mnemonic
+		if info.Name == "LD" && strings.HasPrefix(param, "(FF00+") {
+			res.Mnemonic = "ldh"
+		}
+
+		res.Operands = append(res.Operands, operand)
+	}
+
+	conditional := len(info.Parameters) > 0 && isCondition(info.Parameters[0])
+
+	switch info.Name {
+	case "JP":
+		switch {
+		case info.Parameters[0] == "HL":
+			res.Flow = FlowIndirect
+		case conditional:
+			res.Flow = FlowBranch
+		default:
+			res.Flow = FlowJump
+		}
+	case "JR":
+		if conditional {
+			res.Flow = FlowBranch
+		} else {
+			res.Flow = FlowJump
+		}
+	case "CALL", "RST":
+		res.Flow = FlowCall
+	case "RET":
+		if conditional {
+			res.Flow = FlowBranch
+		} else {
+			res.Flow = FlowReturn
+		}
+	case "RETI":
+		res.Flow = FlowReturn
+	}
+
+	if res.targetOperand >= 0 {
+		res.HasTarget = true
+	} else {
+		res.Target = 0
+	}
+
+	return res
+}
+
+func isCondition(param string) bool {
+	return param == "NZ" || param == "Z" || param == "NC" || param == "C"
+}
+
+// decodeOperand returns an operand in RGBDS syntax, reading the immediate
+// values following the instruction.
+func decodeOperand(read func(addr uint16) uint8, inst *Instruction, param string) string {
+	next := inst.Addr + uint16(len(inst.Bytes))
+
+	imm8 := func() uint8 {
+		val := read(next)
+		inst.Bytes = append(inst.Bytes, val)
+
+		return val
+	}
+
+	imm16 := func() uint16 {
+		lo := read(next)
+		hi := read(next + 1)
+		inst.Bytes = append(inst.Bytes, lo, hi)
+
+		return uint16(lo) | uint16(hi)<<8
+	}
+
+	switch param {
+	case "u8":
+		return fmt.Sprintf("$%02X", imm8())
+	case "u16":
+		val := imm16()
+		inst.Target = val
+
+		return fmt.Sprintf("$%04X", val)
+	case "(u16)":
+		return fmt.Sprintf("[$%04X]", imm16())
+	case "(FF00+u8)":
+		return fmt.Sprintf("[$FF%02X]", imm8())
+	case "(FF00+C)":
+		return "[c]"
+	case "i8":
+		offset := int8(imm8())
+
+		if inst.Mnemonic == "jr" {
+			inst.Target = next + 1 + uint16(offset)
+			return fmt.Sprintf("$%04X", inst.Target)
+		}
+
+		return strconv.Itoa(int(offset))
+	case "SP+i8":
+		offset := int8(imm8())
+		if offset < 0 {
+			return fmt.Sprintf("sp-%d", -int(offset))
+		}
+
+		return fmt.Sprintf("sp+%d", offset)
+	case "00h", "08h", "10h", "18h", "20h", "28h", "30h", "38h":
+		val, _ := strconv.ParseUint(strings.TrimSuffix(param, "h"), 16, 8)
+		inst.Target = uint16(val)
+
+		return fmt.Sprintf("$%02X", val)
+	}
+
+	if strings.HasPrefix(param, "(") {
+		return "[" + strings.ToLower(strings.Trim(param, "()")) + "]"
+	}
+
+	return strings.ToLower(param)
+}
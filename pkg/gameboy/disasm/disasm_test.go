@@ -0,0 +1,98 @@
+package disasm
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+func decodeBytes(addr uint16, data ...uint8) *Instruction {
+	return Decode(func(a uint16) uint8 {
+		if int(a-addr) < len(data) {
+			return data[a-addr]
+		}
+
+		return 0
+	}, addr)
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		data   []uint8
+		text   string
+		length int
+		flow   Flow
+	}{
+		{[]uint8{0x00}, "nop", 1, FlowNext},
+		{[]uint8{0x3e, 0x12}, "ld a, $12", 2, FlowNext},
+		{[]uint8{0x21, 0x34, 0x12}, "ld hl, $1234", 3, FlowNext},
+		{[]uint8{0x22}, "ld [hl+], a", 1, FlowNext},
+		{[]uint8{0xea, 0x00, 0xc0}, "ld [$C000], a", 3, FlowNext},
+		{[]uint8{0xe0, 0x44}, "ldh [$FF44], a", 2, FlowNext},
+		{[]uint8{0xf2}, "ldh a, [c]", 1, FlowNext},
+		{[]uint8{0xf8, 0xfe}, "ld hl, sp-2", 2, FlowNext},
+		{[]uint8{0xe8, 0x05}, "add sp, 5", 2, FlowNext},
+		{[]uint8{0xcb, 0x7c}, "bit 7, h", 2, FlowNext},
+		{[]uint8{0x10, 0x00}, "stop", 2, FlowNext},
+		{[]uint8{0x18, 0xfe}, "jr $0150", 2, FlowJump},
+		{[]uint8{0x20, 0x02}, "jr nz, $0154", 2, FlowBranch},
+		{[]uint8{0xc3, 0x00, 0x40}, "jp $4000", 3, FlowJump},
+		{[]uint8{0xe9}, "jp hl", 1, FlowIndirect},
+		{[]uint8{0xcd, 0x00, 0x02}, "call $0200", 3, FlowCall},
+		{[]uint8{0xff}, "rst $38", 1, FlowCall},
+		{[]uint8{0xc8}, "ret z", 1, FlowBranch},
+		{[]uint8{0xd9}, "reti", 1, FlowReturn},
+		{[]uint8{0xd3}, "db $D3", 1, FlowStop},
+	}
+
+	for _, test := range tests {
+		inst := decodeBytes(0x0150, test.data...)
+
+		if inst.String() != test.text || inst.Length() != test.length || inst.Flow != test.flow {
+			t.Errorf("decode % x error: want %q length %d flow %d, got %q length %d flow %d", test.data, test.text, test.length, test.flow, inst.String(), inst.Length(), inst.Flow)
+		}
+	}
+}
+
+func TestDisassembleROM(t *testing.T) {
+	rom := make([]uint8, 2*BankSize)
+	for i := range rom {
+		rom[i] = 0xff
+	}
+
+	copy(rom[0x0100:], []uint8{0x00, 0xc3, 0x50, 0x01})
+	// 0150: call 0x4000, jr 0150
+	copy(rom[0x0150:], []uint8{0xcd, 0x00, 0x40, 0x18, 0xfb})
+	// 4000: ld a, 1; ret
+	copy(rom[BankSize:], []uint8{0x3e, 0x01, 0xc9})
+
+	syms, err := symbols.Parse(strings.NewReader("00:0150 Main\n00:0160 Data\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := DisassembleROM(rom, syms)
+
+	var buf bytes.Buffer
+	if err := r.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+
+	for _, want := range []string{
+		"SECTION \"ROM Bank $000\", ROM0[$0000]\n",
+		"\nBoot:\n    nop\n    jp Main\n",
+		"\nMain:\n    call Call_001_4000\n    jr Main\n",
+		"\nData:\n    ds 16032, $FF\n",
+		"SECTION \"ROM Bank $001\", ROMX[$4000], BANK[$001]\n\nCall_001_4000:\n    ld a, $01\n    ret\n",
+		"\nRST_38:\n    rst RST_38\n",
+		"    ds 16381, $FF\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output error: missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package disasm
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+const BankSize = 0x4000
+
+// vectors are the entry point and the restart and interrupt vectors, where
+// the code reachable in every rom starts
+var vectors = []struct {
+	addr uint16
+	name string
+}{
+	{0x0000, "RST_00"},
+	{0x0008, "RST_08"},
+	{0x0010, "RST_10"},
+	{0x0018, "RST_18"},
+	{0x0020, "RST_20"},
+	{0x0028, "RST_28"},
+	{0x0030, "RST_30"},
+	{0x0038, "RST_38"},
+	{0x0040, "VBlankInterrupt"},
+	{0x0048, "LCDCInterrupt"},
+	{0x0050, "TimerOverflowInterrupt"},
+	{0x0058, "SerialTransferCompleteInterrupt"},
+	{0x0060, "JoypadTransitionInterrupt"},
+	{0x0100, "Boot"},
+}
+
+// Location is an address in a rom bank.
+type Location struct {
+	Bank int
+	Addr uint16
+}
+
+// ROM is the disassembly of a whole rom. Code is found following the jumps
+// and calls from the vectors, everything else is data. Labels are named after
+// the rom symbols when available.
+type ROM struct {
+	Banks int
+
+	data   []uint8
+	insts  map[Location]*Instruction
+	inside map[Location]bool
+	labels map[Location]string
+}
+
+func DisassembleROM(data []uint8, syms *symbols.Table) *ROM {
+	banks := (len(data) + BankSize - 1) / BankSize
+	if banks < 2 {
+		banks = 2
+	}
+
+	r := &ROM{
+		Banks:  banks,
+		data:   data,
+		insts:  make(map[Location]*Instruction),
+		inside: make(map[Location]bool),
+		labels: make(map[Location]string),
+	}
+
+	for _, sym := range syms.Symbols() {
+		loc := Location{Bank: sym.Bank, Addr: sym.Addr}
+
+		if _, ok := r.labels[loc]; !ok && r.contains(loc) {
+			r.labels[loc] = sym.Name
+		}
+	}
+
+	var queue []Location
+
+	for _, vector := range vectors {
+		loc := Location{Bank: 0, Addr: vector.addr}
+		if _, ok := r.labels[loc]; !ok {
+			r.labels[loc] = vector.name
+		}
+
+		queue = append(queue, loc)
+	}
+
+	for len(queue) > 0 {
+		loc := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		queue = r.trace(loc, queue)
+	}
+
+	return r
+}
+
+// Read returns the byte at addr as seen with bank mapped at 0x4000.
+func (r *ROM) Read(bank int, addr uint16) uint8 {
+	offset := int(addr)
+
+	if addr >= BankSize {
+		if bank == 0 {
+			bank = 1
+		}
+
+		offset = bank*BankSize + int(addr) - BankSize
+	}
+
+	if addr >= 2*BankSize || offset >= len(r.data) {
+		return 0xff
+	}
+
+	return r.data[offset]
+}
+
+// Instruction returns the instruction starting at loc, nil if loc isn't code.
+func (r *ROM) Instruction(loc Location) *Instruction {
+	return r.insts[loc]
+}
+
+// Label returns the label at loc, empty if there is none.
+func (r *ROM) Label(loc Location) string {
+	// labels in the middle of instructions can't be written
+	if r.inside[loc] {
+		return ""
+	}
+
+	return r.labels[loc]
+}
+
+func (r *ROM) contains(loc Location) bool {
+	if loc.Bank == 0 {
+		return loc.Addr < BankSize
+	}
+
+	return loc.Bank < r.Banks && loc.Addr >= BankSize && loc.Addr < 2*BankSize
+}
+
+// target returns the location of the target of an instruction in bank, the
+// switchable bank is known only for code in it or for roms without banking.
+func (r *ROM) target(bank int, addr uint16) (Location, bool) {
+	switch {
+	case addr < BankSize:
+		return Location{Bank: 0, Addr: addr}, true
+	case addr < 2*BankSize && bank != 0:
+		return Location{Bank: bank, Addr: addr}, true
+	case addr < 2*BankSize && r.Banks == 2:
+		return Location{Bank: 1, Addr: addr}, true
+	default:
+		return Location{}, false
+	}
+}
+
+// trace decodes the code from loc to the end of its flow, appending the
+// targets found to queue.
+func (r *ROM) trace(loc Location, queue []Location) []Location {
+	read := func(addr uint16) uint8 {
+		return r.Read(loc.Bank, addr)
+	}
+
+	for r.contains(loc) && r.insts[loc] == nil && !r.inside[loc] {
+		inst := Decode(read, loc.Addr)
+
+		end := Location{Bank: loc.Bank, Addr: loc.Addr + uint16(inst.Length()) - 1}
+		if !r.contains(end) {
+			break
+		}
+
+		for i := 1; i < inst.Length(); i++ {
+			if r.insts[Location{Bank: loc.Bank, Addr: loc.Addr + uint16(i)}] != nil {
+				return queue
+			}
+		}
+
+		r.insts[loc] = inst
+		for i := 1; i < inst.Length(); i++ {
+			r.inside[Location{Bank: loc.Bank, Addr: loc.Addr + uint16(i)}] = true
+		}
+
+		if inst.HasTarget {
+			if target, ok := r.target(loc.Bank, inst.Target); ok {
+				if _, ok := r.labels[target]; !ok {
+					r.labels[target] = labelName(inst, target)
+				}
+
+				queue = append(queue, target)
+			}
+		}
+
+		if inst.Flow.Ends() {
+			break
+		}
+
+		loc.Addr += uint16(inst.Length())
+	}
+
+	return queue
+}
+
+func labelName(inst *Instruction, loc Location) string {
+	prefix := "Jump"
+
+	switch inst.Mnemonic {
+	case "call", "rst":
+		prefix = "Call"
+	case "jr":
+		prefix = "jr"
+	}
+
+	return fmt.Sprintf("%s_%03X_%04X", prefix, loc.Bank, loc.Addr)
+}
+
+// Write writes the disassembly in RGBDS syntax, a section for every bank.
+func (r *ROM) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	for bank := 0; bank < r.Banks; bank++ {
+		start := uint16(0)
+
+		if bank == 0 {
+			fmt.Fprintf(bw, "SECTION \"ROM Bank $000\", ROM0[$0000]\n")
+		} else {
+			start = BankSize
+			fmt.Fprintf(bw, "\nSECTION \"ROM Bank $%03X\", ROMX[$4000], BANK[$%03X]\n", bank, bank)
+		}
+
+		label := func(addr uint16) string {
+			if loc, ok := r.target(bank, addr); ok {
+				return r.Label(loc)
+			}
+
+			return ""
+		}
+
+		for offset := 0; offset < BankSize; {
+			loc := Location{Bank: bank, Addr: start + uint16(offset)}
+
+			if name := r.Label(loc); name != "" {
+				fmt.Fprintf(bw, "\n%s:\n", name)
+			}
+
+			if inst := r.insts[loc]; inst != nil {
+				fmt.Fprintf(bw, "    %s\n", inst.Format(label))
+				offset += inst.Length()
+				continue
+			}
+
+			size := 1
+			for offset+size < BankSize {
+				next := Location{Bank: bank, Addr: loc.Addr + uint16(size)}
+				if r.insts[next] != nil || r.Label(next) != "" {
+					break
+				}
+
+				size++
+			}
+
+			r.writeData(bw, bank, loc.Addr, size)
+			offset += size
+		}
+	}
+
+	return bw.Flush()
+}
+
+// writeData writes size bytes of data, with ds for the long runs of the same
+// byte, as the padding, and db for the rest.
+func (r *ROM) writeData(w io.Writer, bank int, addr uint16, size int) {
+	var line []string
+
+	flush := func() {
+		if len(line) > 0 {
+			fmt.Fprintf(w, "    db %s\n", strings.Join(line, ", "))
+			line = nil
+		}
+	}
+
+	for i := 0; i < size; {
+		val := r.Read(bank, addr+uint16(i))
+
+		run := 1
+		for i+run < size && r.Read(bank, addr+uint16(i+run)) == val {
+			run++
+		}
+
+		if run >= 16 {
+			flush()
+			fmt.Fprintf(w, "    ds %d, $%02X\n", run, val)
+			i += run
+			continue
+		}
+
+		line = append(line, fmt.Sprintf("$%02X", val))
+		if len(line) == 8 {
+			flush()
+		}
+
+		i++
+	}
+
+	flush()
+}
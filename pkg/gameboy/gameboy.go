@@ -1,28 +1,51 @@
 package gameboy
 
 import (
-	"fmt"
-	"github.com/adnsio/gbemu/pkg/gameboy/bits"
 	"github.com/adnsio/gbemu/pkg/gameboy/cpu"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware"
-	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/rewind"
 )
 
 type Config struct {
 	Bootrom   []uint8
 	Cartridge []uint8
+	// RewindInterval is the number of frames between rewind snapshots, 0 disables rewind
+	RewindInterval int
+	// RewindDepth is the maximum number of rewind snapshots kept
+	RewindDepth int
+	// Logger receives the messages of the emulator, nil is silent
+	Logger logger.Logger
+}
+
+// Tracer is called before every instruction.
+type Tracer interface {
+	Trace(gb *GameBoy)
 }
 
 type GameBoy struct {
-	ClockSpeed    int
-	CPU           *cpu.CPU
-	Hardware      *hardware.Hardware
-	DisplayCycles int
-	Paused        bool
-	ForcedPause   bool
+	ClockSpeed int
+	CPU        *cpu.CPU
+	Hardware   *hardware.Hardware
+	// Frame is the number of frames completed
+	Frame int
+	// FrameCycles is the number of cycles run in the current frame
+	FrameCycles int
+	Paused      bool
+	ForcedPause bool
+	Tracer      Tracer
+	Log         logger.Logger
+
+	rewindInterval int
+	rewindFrames   int
+	rewindBuffer   *rewind.Buffer
+	// rewindCurrent is set when the newest rewind snapshot is the current state
+	rewindCurrent bool
 }
 
-func NewGameBoy(cfg Config) *GameBoy {
+// NewGameBoy returns a game boy powered on with the configured roms, it returns
+// an error if a rom can't be loaded.
+func NewGameBoy(cfg Config) (*GameBoy, error) {
 	hwe := hardware.NewHardware()
 	cpu := cpu.NewCPU(hwe)
 
@@ -30,10 +53,24 @@ func NewGameBoy(cfg Config) *GameBoy {
 		ClockSpeed: 4194304,
 		Hardware:   hwe,
 		CPU:        cpu,
+		Log:        cfg.Logger,
 	}
 
+	if gb.Log == nil {
+		gb.Log = logger.Discard
+	}
+
+	hwe.SetLogger(gb.Log)
+	cpu.Log = gb.Log
+
+	// the hardware events are run as the instructions access the memory
+	cpu.Tick = hwe.Scheduler.Advance
+
 	if cfg.Bootrom != nil {
-		hwe.Bootrom.Load(cfg.Bootrom)
+		if err := hwe.Bootrom.Load(cfg.Bootrom); err != nil {
+			return nil, err
+		}
+
 		hwe.Bootrom.Enabled = true
 	} else {
 		cpu.WriteAF(0x01b0)
@@ -49,135 +86,66 @@ func NewGameBoy(cfg Config) *GameBoy {
 	}
 
 	if cfg.Cartridge != nil {
-		hwe.Cartrdige.Load(cfg.Cartridge)
+		if err := hwe.Cartrdige.Load(cfg.Cartridge); err != nil {
+			return nil, err
+		}
 	}
 
-	return gb
+	if cfg.RewindInterval > 0 {
+		gb.rewindInterval = cfg.RewindInterval
+		gb.rewindBuffer = rewind.NewBuffer(cfg.RewindDepth)
+	}
+
+	return gb, nil
 }
 
-func (gb *GameBoy) RunFrame() {
+// RunFrame runs the game boy until the end of the frame, it returns an error
+// if an instruction can't be executed, the frame is left unfinished.
+func (gb *GameBoy) RunFrame() error {
 	if gb.Paused || gb.ForcedPause {
-		return
+		return nil
 	}
 
-	maxCyclesPerFrame := gb.ClockSpeed / 60
-	frameCycles := 0
-
-	for frameCycles < maxCyclesPerFrame {
-		cycles := gb.CPU.ExecuteNextInstruction()
-		frameCycles += cycles
-
-		gb.Hardware.Timer.Update(cycles)
-		gb.UpdateDisplay(cycles) // todo move to Display
-		// todo run interrupts
+	gb.rewindCurrent = false
 
-		if gb.CPU.PC == 0x008f {
-			fmt.Println("end bootrom scroll")
-			//gb.Paused = true
-			//gb.ForcedPause = true
-		}
-
-		if gb.CPU.PC == 0x00fe {
-			fmt.Println("end bootrom")
-			//gb.Paused = true
-			//gb.ForcedPause = true
+	for frame := gb.Frame; gb.Frame == frame; {
+		if _, err := gb.Step(); err != nil {
+			return err
 		}
+	}
 
-		if gb.CPU.PC == 0x0254 { // tetris write to cartridge
-			fmt.Println("debug")
-			//gb.Paused = true
-			//gb.ForcedPause = true
-		}
+	if gb.rewindBuffer != nil {
+		gb.rewindFrames++
 
-		if gb.CPU.PC == 0x0286 { // tetris write to not usable ram
-			fmt.Println("debug")
-			//gb.Paused = true
-			//gb.ForcedPause = true
+		if gb.rewindFrames >= gb.rewindInterval {
+			gb.rewindFrames = 0
+			gb.pushRewindSnapshot()
 		}
 	}
 
-	//fmt.Printf("frame cycles %d\n", frameCycles)
+	return nil
 }
 
-func (gb *GameBoy) UpdateDisplay(cycles int) {
-	isDisplayEnabled := bits.Test(gb.Hardware.Display.Control, display.ControlDisplayEnabled)
-
-	if !isDisplayEnabled {
-		gb.DisplayCycles = 456
-		gb.Hardware.Display.CurrentLine = 0
-		// mode 1
-		gb.Hardware.Display.Status &= 252
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, 0)
-		return
-	}
-
-	mode := gb.Hardware.Display.Status & 0x3
-	nextMode := uint8(0)
-	requestInterrupt := false
-	mode2Cycles := 456 - 80
-	mode3Cycles := mode2Cycles - 172
-
-	switch {
-	case gb.Hardware.Display.CurrentLine >= 144:
-		nextMode = 1
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, 0)
-		gb.Hardware.Display.Status = bits.Clear(gb.Hardware.Display.Status, 1)
-
-		requestInterrupt = bits.Test(gb.Hardware.Display.Status, hardware.STAT_MODE1_VBLANK_INTERRUPT)
-	case gb.DisplayCycles >= mode2Cycles:
-		// mode 2
-		nextMode = 2
-		gb.Hardware.Display.Status = bits.Clear(gb.Hardware.Display.Status, 0)
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, 1)
-
-		requestInterrupt = bits.Test(gb.Hardware.Display.Status, hardware.STAT_MODE2_OAM_INTERRUPT)
-	case gb.DisplayCycles >= mode3Cycles:
-		nextMode = 3
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, 0)
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, 1)
-
-		if nextMode != mode {
-			gb.Hardware.Display.DrawLine()
-		}
-	default:
-		nextMode = 0
-		gb.Hardware.Display.Status = bits.Clear(gb.Hardware.Display.Status, 0)
-		gb.Hardware.Display.Status = bits.Clear(gb.Hardware.Display.Status, 1)
-
-		requestInterrupt = bits.Test(gb.Hardware.Display.Status, hardware.STAT_MODE0_HBLANK_INTERRUPT)
-
-		if nextMode != mode {
-			// todo hdma transfer
-		}
+// Step executes the next instruction, running the hardware events due, it
+// returns the elapsed cycles.
+func (gb *GameBoy) Step() (int, error) {
+	// the prefix and the prefixed instruction are traced as one
+	if gb.Tracer != nil && !gb.CPU.IsNextInstructionPrefixed {
+		gb.Tracer.Trace(gb)
 	}
 
-	if requestInterrupt && mode != nextMode {
-		// todo request interrupt 1
+	cycles, err := gb.CPU.ExecuteNextInstruction()
+	if err != nil {
+		return cycles, err
 	}
 
-	if gb.Hardware.Display.CurrentLine == gb.Hardware.Display.CompareLine {
-		gb.Hardware.Display.Status = bits.Set(gb.Hardware.Display.Status, hardware.STAT_COINCIDENCE_FLAG)
+	// todo run interrupts
 
-		if bits.Test(gb.Hardware.Display.Status, hardware.STAT_COINCIDENCE_INTERRUPT) {
-			// todo request interrupt 1
-		}
-	} else {
-		gb.Hardware.Display.Status = bits.Clear(gb.Hardware.Display.Status, hardware.STAT_COINCIDENCE_FLAG)
+	gb.FrameCycles += cycles
+	if gb.FrameCycles >= gb.ClockSpeed/60 {
+		gb.FrameCycles = 0
+		gb.Frame++
 	}
 
-	gb.DisplayCycles -= cycles
-
-	if gb.DisplayCycles <= 0 {
-		gb.Hardware.Display.CurrentLine++
-
-		if gb.Hardware.Display.CurrentLine > 153 {
-			gb.Hardware.Display.CurrentLine = 0
-		}
-
-		gb.DisplayCycles = 456
-
-		if gb.Hardware.Display.CurrentLine == 144 {
-			// todo request interrupt 0
-		}
-	}
+	return cycles, nil
 }
@@ -0,0 +1,188 @@
+package gameboy
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/rewind"
+)
+
+// newTestGameBoy returns a game boy without bootrom running code at 0x0100.
+func newTestGameBoy(t testing.TB, code ...uint8) *GameBoy {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], code)
+
+	gb, err := NewGameBoy(Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return gb
+}
+
+// counterCode increments A and stores it in 0xc000 forever.
+var counterCode = []uint8{0x3c, 0xea, 0x00, 0xc0, 0x18, 0xfa}
+
+func TestGameBoy_SaveState(t *testing.T) {
+	gb := newTestGameBoy(t, counterCode...)
+	gb.RunFrame()
+
+	var buf bytes.Buffer
+	if err := gb.SaveState(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	wantA, wantPC, wantRAM := gb.CPU.A, gb.CPU.PC, gb.Hardware.WorkRamBank0[0]
+
+	gb.RunFrame()
+
+	if gb.CPU.A == wantA && gb.CPU.PC == wantPC {
+		t.Fatal("state did not change after a frame")
+	}
+
+	if err := gb.LoadState(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if gb.CPU.A != wantA || gb.CPU.PC != wantPC || gb.Hardware.WorkRamBank0[0] != wantRAM {
+		t.Errorf("state error: want A %#02x PC %#04x RAM %#02x, got A %#02x PC %#04x RAM %#02x", wantA, wantPC, wantRAM, gb.CPU.A, gb.CPU.PC, gb.Hardware.WorkRamBank0[0])
+	}
+}
+
+func TestGameBoy_Rewind(t *testing.T) {
+	gb := newTestGameBoy(t, counterCode...)
+	gb.rewindInterval = 1
+	gb.rewindBuffer = rewind.NewBuffer(4)
+
+	if err := gb.Rewind(1); err == nil {
+		t.Error("empty rewind error: want error, got nil")
+	}
+
+	var pcs []uint16
+	for i := 0; i < 6; i++ {
+		gb.RunFrame()
+		pcs = append(pcs, gb.CPU.PC)
+	}
+
+	if err := gb.Rewind(2); err != nil {
+		t.Fatal(err)
+	}
+
+	if gb.CPU.PC != pcs[3] {
+		t.Errorf("rewind error: want PC %#04x, got %#04x", pcs[3], gb.CPU.PC)
+	}
+
+	if err := gb.Rewind(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if gb.CPU.PC != pcs[2] {
+		t.Errorf("rewind error: want PC %#04x, got %#04x", pcs[2], gb.CPU.PC)
+	}
+
+	// the buffer keeps the last 4 snapshots only
+	if err := gb.Rewind(1); err == nil {
+		t.Errorf("rewind error: want error, got PC %#04x", gb.CPU.PC)
+	}
+}
+
+func TestNewGameBoy_Errors(t *testing.T) {
+	if _, err := NewGameBoy(Config{Cartridge: make([]uint8, 0x100)}); err == nil {
+		t.Error("short cartridge error: want error, got nil")
+	}
+
+	rom := make([]uint8, 0x8000)
+	rom[0x0147] = 0xfc
+
+	if _, err := NewGameBoy(Config{Cartridge: rom}); err == nil {
+		t.Error("cartridge type error: want error, got nil")
+	}
+
+	if _, err := NewGameBoy(Config{Bootrom: make([]uint8, 0x10)}); err == nil {
+		t.Error("bootrom error: want error, got nil")
+	}
+}
+
+func TestGameBoy_RunFrameErrors(t *testing.T) {
+	// HALT is not implemented
+	gb := newTestGameBoy(t, 0x00, 0x76)
+
+	if err := gb.RunFrame(); err == nil {
+		t.Error("invalid instruction error: want error, got nil")
+	}
+
+	if gb.CPU.PC != 0x0101 {
+		t.Errorf("PC error: want 0x0101, got %#04x", gb.CPU.PC)
+	}
+
+	// the unused opcodes lock the cpu up, frames keep running
+	gb = newTestGameBoy(t, 0xd3)
+
+	for i := 0; i < 2; i++ {
+		if err := gb.RunFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !gb.CPU.Locked || gb.CPU.PC != 0x0100 || gb.Frame != 2 {
+		t.Errorf("lock up error: locked %t, PC %#04x, frame %d", gb.CPU.Locked, gb.CPU.PC, gb.Frame)
+	}
+}
+
+func TestGameBoy_TimerRateChange(t *testing.T) {
+	// LD A,$05; LDH ($07),A; LD A,$04; LDH ($07),A; JR -2, the timer moves
+	// from 16 to 1024 cycles a tick
+	gb := newTestGameBoy(t, 0x3e, 0x05, 0xe0, 0x07, 0x3e, 0x04, 0xe0, 0x07, 0x18, 0xfe)
+
+	for i := 0; i < 2; i++ {
+		if err := gb.RunFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if gb.Hardware.Timer.Counter == 0 {
+		t.Error("timer error: counter not running")
+	}
+}
+
+// copyCode copies the rom to the work ram, reading LY after every byte, as
+// games do with their data:
+//
+//	0100 LD HL,$0200
+//	0103 LD DE,$C100
+//	0106 LD B,$00
+//	0108 LD A,(HL+)
+//	0109 LD (DE),A
+//	010A INC DE
+//	010B LDH A,($44)
+//	010D DEC B
+//	010E JR NZ,$0108
+//	0110 JR $0100
+var copyCode = []uint8{0x21, 0x00, 0x02, 0x11, 0x00, 0xc1, 0x06, 0x00, 0x2a, 0x12, 0x13, 0xf0, 0x44, 0x05, 0x20, 0xf8, 0x18, 0xee}
+
+func BenchmarkGameBoy_RunFrameCopy(b *testing.B) {
+	gb := newTestGameBoy(b, copyCode...)
+	start := time.Now()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := gb.RunFrame(); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(b.N*gb.ClockSpeed/60)/time.Since(start).Seconds()/1e6, "MHz")
+}
+
+func BenchmarkGameBoy_RunFrame(b *testing.B) {
+	gb := newTestGameBoy(b, counterCode...)
+	start := time.Now()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gb.RunFrame()
+	}
+
+	b.ReportMetric(float64(b.N*gb.ClockSpeed/60)/time.Since(start).Seconds()/1e6, "MHz")
+}
@@ -0,0 +1,523 @@
+package gdb
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+)
+
+const (
+	// registers are numbered in this order, as 16 bit little endian values
+	regAF = iota
+	regBC
+	regDE
+	regHL
+	regSP
+	regPC
+	numRegs
+
+	sigInt  = 0x02
+	sigIll  = 0x04
+	sigTrap = 0x05
+
+	interruptByte = 0x03
+)
+
+// Server is a GDB remote serial protocol stub controlling a game boy. The
+// registers are AF, BC, DE, HL, SP and PC, numbered from 0, and memory is
+// accessed through the hardware as seen by the cpu.
+//
+// The game boy runs only when requested by the debugger, in the goroutine
+// serving the connection.
+type Server struct {
+	GameBoy     *gameboy.GameBoy
+	Breakpoints map[uint16]bool
+
+	writer      *bufio.Writer
+	writeLock   sync.Mutex
+	noAck       int32
+	interrupted int32
+}
+
+func NewServer(gb *gameboy.GameBoy) *Server {
+	return &Server{
+		GameBoy:     gb,
+		Breakpoints: make(map[uint16]bool),
+	}
+}
+
+// ListenAndServe waits for a debugger connecting to addr and serves it until
+// it detaches.
+func (s *Server) ListenAndServe(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer ln.Close()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(conn)
+}
+
+// Serve serves the debugger connected to conn, it returns when the debugger
+// detaches or the connection is closed.
+func (s *Server) Serve(conn io.ReadWriteCloser) error {
+	s.writer = bufio.NewWriter(conn)
+	atomic.StoreInt32(&s.noAck, 0)
+	defer conn.Close()
+
+	packets := make(chan string, 16)
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- s.readPackets(bufio.NewReader(conn), packets)
+		close(packets)
+	}()
+
+	for packet := range packets {
+		// kill has no reply
+		if packet == "k" {
+			return nil
+		}
+
+		reply, done := s.handle(packet)
+
+		if err := s.send(reply); err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+
+	if err := <-errs; err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// readPackets reads the packets, acknowledging them, until the connection
+// is closed. The interrupt byte is handled here, as it's sent while the game
+// boy is running.
+func (s *Server) readPackets(r *bufio.Reader, packets chan<- string) error {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		switch b {
+		case interruptByte:
+			atomic.StoreInt32(&s.interrupted, 1)
+			continue
+		case '$':
+		default:
+			// acknowledgments and noise between packets
+			continue
+		}
+
+		data, err := r.ReadString('#')
+		if err != nil {
+			return err
+		}
+		data = data[:len(data)-1]
+
+		var sum [2]byte
+		if _, err := io.ReadFull(r, sum[:]); err != nil {
+			return err
+		}
+
+		if atomic.LoadInt32(&s.noAck) == 0 {
+			ack := "+"
+			if want, err := strconv.ParseUint(string(sum[:]), 16, 8); err != nil || uint8(want) != checksum(data) {
+				ack = "-"
+			}
+
+			if err := s.write(ack); err != nil {
+				return err
+			}
+
+			if ack == "-" {
+				continue
+			}
+		}
+
+		packets <- unescape(data)
+	}
+}
+
+func checksum(data string) uint8 {
+	var res uint8
+	for i := 0; i < len(data); i++ {
+		res += data[i]
+	}
+
+	return res
+}
+
+// unescape decodes the bytes escaped with } in binary data.
+func unescape(data string) string {
+	if !strings.Contains(data, "}") {
+		return data
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(data); i++ {
+		if data[i] == '}' && i+1 < len(data) {
+			i++
+			b.WriteByte(data[i] ^ 0x20)
+			continue
+		}
+
+		b.WriteByte(data[i])
+	}
+
+	return b.String()
+}
+
+func (s *Server) write(data string) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	s.writer.WriteString(data)
+
+	return s.writer.Flush()
+}
+
+// send sends a packet, without waiting for the acknowledgment.
+func (s *Server) send(data string) error {
+	return s.write(fmt.Sprintf("$%s#%02x", data, checksum(data)))
+}
+
+// handle executes a packet and returns the reply, done is true when the
+// session ends.
+func (s *Server) handle(packet string) (reply string, done bool) {
+	if packet == "" {
+		return "", false
+	}
+
+	cmd, args := packet[0], packet[1:]
+
+	switch cmd {
+	case '?':
+		return stopReply(sigTrap), false
+	case 'g':
+		return s.readRegisters(), false
+	case 'G':
+		return s.writeRegisters(args), false
+	case 'p':
+		return s.readRegister(args), false
+	case 'P':
+		return s.writeRegister(args), false
+	case 'm':
+		return s.readMemory(args), false
+	case 'M':
+		return s.writeMemory(args), false
+	case 'Z', 'z':
+		return s.breakpoint(cmd == 'Z', args), false
+	case 's':
+		if reply := s.jump(args); reply != "" {
+			return reply, false
+		}
+
+		if err := s.step(); err != nil {
+			return stopReply(sigIll), false
+		}
+
+		return stopReply(sigTrap), false
+	case 'c':
+		if reply := s.jump(args); reply != "" {
+			return reply, false
+		}
+
+		return stopReply(s.resume()), false
+	case 'H':
+		return "OK", false
+	case 'D':
+		return "OK", true
+	case 'q':
+		return s.query(args), false
+	case 'Q':
+		if args == "StartNoAckMode" {
+			defer atomic.StoreInt32(&s.noAck, 1)
+			return "OK", false
+		}
+	}
+
+	return "", false
+}
+
+func (s *Server) query(args string) string {
+	name := args
+	if i := strings.IndexAny(args, ":,"); i >= 0 {
+		name = args[:i]
+	}
+
+	switch name {
+	case "Supported":
+		return "PacketSize=1000;QStartNoAckMode+"
+	case "Attached":
+		return "1"
+	case "C":
+		return "QC1"
+	case "fThreadInfo":
+		return "m1"
+	case "sThreadInfo":
+		return "l"
+	}
+
+	return ""
+}
+
+func stopReply(signal int) string {
+	return fmt.Sprintf("S%02x", signal)
+}
+
+func errorReply(code int) string {
+	return fmt.Sprintf("E%02x", code)
+}
+
+func (s *Server) register(n int) uint16 {
+	c := s.GameBoy.CPU
+
+	switch n {
+	case regAF:
+		return uint16(c.A)<<8 | uint16(c.F.Read())
+	case regBC:
+		return uint16(c.B)<<8 | uint16(c.C)
+	case regDE:
+		return uint16(c.D)<<8 | uint16(c.E)
+	case regHL:
+		return uint16(c.H)<<8 | uint16(c.L)
+	case regSP:
+		return c.SP
+	default:
+		return c.PC
+	}
+}
+
+func (s *Server) setRegister(n int, val uint16) {
+	c := s.GameBoy.CPU
+	hi, lo := uint8(val>>8), uint8(val)
+
+	switch n {
+	case regAF:
+		c.A = hi
+		c.F.Write(lo)
+	case regBC:
+		c.B, c.C = hi, lo
+	case regDE:
+		c.D, c.E = hi, lo
+	case regHL:
+		c.H, c.L = hi, lo
+	case regSP:
+		c.SP = val
+	case regPC:
+		c.PC = val
+	}
+}
+
+func (s *Server) readRegisters() string {
+	data := make([]byte, 0, numRegs*2)
+	for n := 0; n < numRegs; n++ {
+		val := s.register(n)
+		data = append(data, uint8(val), uint8(val>>8))
+	}
+
+	return hex.EncodeToString(data)
+}
+
+func (s *Server) writeRegisters(args string) string {
+	data, err := hex.DecodeString(args)
+	if err != nil || len(data) < numRegs*2 {
+		return errorReply(1)
+	}
+
+	for n := 0; n < numRegs; n++ {
+		s.setRegister(n, uint16(data[n*2])|uint16(data[n*2+1])<<8)
+	}
+
+	return "OK"
+}
+
+func (s *Server) readRegister(args string) string {
+	n, err := strconv.ParseUint(args, 16, 8)
+	if err != nil || n >= numRegs {
+		return errorReply(1)
+	}
+
+	val := s.register(int(n))
+
+	return hex.EncodeToString([]byte{uint8(val), uint8(val >> 8)})
+}
+
+func (s *Server) writeRegister(args string) string {
+	parts := strings.SplitN(args, "=", 2)
+	if len(parts) != 2 {
+		return errorReply(1)
+	}
+
+	n, err := strconv.ParseUint(parts[0], 16, 8)
+	if err != nil || n >= numRegs {
+		return errorReply(1)
+	}
+
+	data, err := hex.DecodeString(parts[1])
+	if err != nil || len(data) != 2 {
+		return errorReply(1)
+	}
+
+	s.setRegister(int(n), uint16(data[0])|uint16(data[1])<<8)
+
+	return "OK"
+}
+
+// parseRange parses addr,length as in the memory packets.
+func parseRange(args string) (uint16, int, error) {
+	parts := strings.SplitN(args, ",", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("gdb: invalid range %q", args)
+	}
+
+	addr, err := strconv.ParseUint(parts[0], 16, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gdb: invalid address %q", parts[0])
+	}
+
+	length, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("gdb: invalid length %q", parts[1])
+	}
+
+	return uint16(addr), int(length), nil
+}
+
+func (s *Server) readMemory(args string) string {
+	addr, length, err := parseRange(args)
+	if err != nil {
+		return errorReply(1)
+	}
+
+	data := make([]byte, length)
+	for i := range data {
+		data[i] = s.GameBoy.Hardware.Read(addr + uint16(i))
+	}
+
+	return hex.EncodeToString(data)
+}
+
+func (s *Server) writeMemory(args string) string {
+	parts := strings.SplitN(args, ":", 2)
+	if len(parts) != 2 {
+		return errorReply(1)
+	}
+
+	addr, length, err := parseRange(parts[0])
+	if err != nil {
+		return errorReply(1)
+	}
+
+	data, err := hex.DecodeString(parts[1])
+	if err != nil || len(data) != length {
+		return errorReply(1)
+	}
+
+	for i, val := range data {
+		s.GameBoy.Hardware.Write(addr+uint16(i), val)
+	}
+
+	return "OK"
+}
+
+// breakpoint inserts or removes a breakpoint, the hardware ones are handled
+// as the software ones, watchpoints aren't supported.
+func (s *Server) breakpoint(insert bool, args string) string {
+	parts := strings.Split(args, ",")
+	if len(parts) < 2 || (parts[0] != "0" && parts[0] != "1") {
+		return ""
+	}
+
+	addr, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return errorReply(1)
+	}
+
+	if insert {
+		s.Breakpoints[uint16(addr)] = true
+	} else {
+		delete(s.Breakpoints, uint16(addr))
+	}
+
+	return "OK"
+}
+
+// jump sets PC to the optional address of the step and continue packets, it
+// returns an error reply if the address is invalid.
+func (s *Server) jump(args string) string {
+	if args == "" {
+		return ""
+	}
+
+	addr, err := strconv.ParseUint(args, 16, 16)
+	if err != nil {
+		return errorReply(1)
+	}
+
+	s.GameBoy.CPU.PC = uint16(addr)
+
+	return ""
+}
+
+// step executes a whole instruction, prefix included.
+func (s *Server) step() (err error) {
+	// a bug in the core must not end the debugging session
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	for {
+		if _, err := s.GameBoy.Step(); err != nil {
+			return err
+		}
+
+		if !s.GameBoy.CPU.IsNextInstructionPrefixed {
+			return nil
+		}
+	}
+}
+
+// resume runs until a breakpoint or an interrupt from the debugger and
+// returns the signal reported. The breakpoint on the first instruction is
+// ignored, so continuing from a breakpoint doesn't stop immediately.
+func (s *Server) resume() int {
+	for {
+		if err := s.step(); err != nil {
+			return sigIll
+		}
+
+		if s.Breakpoints[s.GameBoy.CPU.PC] {
+			return sigTrap
+		}
+
+		// the interrupt may be read before the continue packet is handled
+		if atomic.SwapInt32(&s.interrupted, 0) != 0 {
+			return sigInt
+		}
+	}
+}
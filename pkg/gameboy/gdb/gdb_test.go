@@ -0,0 +1,208 @@
+package gdb
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+)
+
+// client is a scripted debugger speaking the remote serial protocol.
+type client struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// newTestClient serves over loopback a game boy running a program calling a
+// function incrementing B from a loop incrementing A:
+//
+//	0100 CALL $0200
+//	0103 INC A
+//	0104 JR $0100
+//	0200 INC B
+//	0201 RET
+func newTestClient(t *testing.T) (*client, *Server, chan error) {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0xcd, 0x00, 0x02, 0x3c, 0x18, 0xfa})
+	copy(rom[0x0200:], []uint8{0x04, 0xc9})
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewServer(gb)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		defer ln.Close()
+
+		conn, err := ln.Accept()
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		errs <- s.Serve(conn)
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &client{t: t, conn: conn, reader: bufio.NewReader(conn)}, s, errs
+}
+
+// request sends a packet and returns the reply.
+func (c *client) request(data string) string {
+	c.t.Helper()
+
+	fmt.Fprintf(c.conn, "$%s#%02x", data, checksum(data))
+
+	if ack, err := c.reader.ReadByte(); err != nil || ack != '+' {
+		c.t.Fatalf("%s ack error: got %q (%v)", data, ack, err)
+	}
+
+	return c.reply()
+}
+
+func (c *client) reply() string {
+	c.t.Helper()
+
+	if _, err := c.reader.ReadString('$'); err != nil {
+		c.t.Fatal(err)
+	}
+
+	reply, err := c.reader.ReadString('#')
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	reply = strings.TrimSuffix(reply, "#")
+
+	var sum [2]byte
+	if _, err := io.ReadFull(c.reader, sum[:]); err != nil {
+		c.t.Fatal(err)
+	}
+
+	if fmt.Sprintf("%02x", checksum(reply)) != string(sum[:]) {
+		c.t.Errorf("%q checksum error: got %s", reply, sum)
+	}
+
+	c.conn.Write([]byte("+"))
+
+	return reply
+}
+
+func (c *client) expect(data string, want string) {
+	c.t.Helper()
+
+	if got := c.request(data); got != want {
+		c.t.Errorf("%s error: want %q, got %q", data, want, got)
+	}
+}
+
+func TestServer_Registers(t *testing.T) {
+	c, s, _ := newTestClient(t)
+	defer c.conn.Close()
+
+	c.expect("?", "S05")
+	// AF 01B0, BC 0013, DE 00D8, HL 014D, SP FFFE, PC 0100
+	c.expect("g", "b0011300d8004d01feff0001")
+	c.expect("p5", "0001")
+
+	c.expect("P1=3412", "OK")
+	c.expect("p1", "3412")
+
+	cpu := s.GameBoy.CPU
+	if cpu.B != 0x12 || cpu.C != 0x34 {
+		t.Errorf("write register error: want BC 0x1234, got %#02x%02x", cpu.B, cpu.C)
+	}
+
+	c.expect("G"+"00ff"+"0000"+"0000"+"00c0"+"f0ff"+"0302", "OK")
+	if cpu.A != 0xff || cpu.F.Read() != 0 || cpu.H != 0xc0 || cpu.SP != 0xfff0 || cpu.PC != 0x0203 {
+		t.Errorf("write registers error: got A %#02x F %#02x H %#02x SP %#04x PC %#04x", cpu.A, cpu.F.Read(), cpu.H, cpu.SP, cpu.PC)
+	}
+
+	c.expect("p6", "E01")
+}
+
+func TestServer_Memory(t *testing.T) {
+	c, s, _ := newTestClient(t)
+	defer c.conn.Close()
+
+	c.expect("m100,6", "cd00023c18fa")
+	c.expect("Mc000,3:010203", "OK")
+	c.expect("mc000,3", "010203")
+
+	if val := s.GameBoy.Hardware.Read(0xc001); val != 0x02 {
+		t.Errorf("write memory error: want 0x02, got %#02x", val)
+	}
+
+	c.expect("Mc000,2:01", "E01")
+}
+
+func TestServer_Execution(t *testing.T) {
+	c, s, errs := newTestClient(t)
+	defer c.conn.Close()
+
+	cpu := s.GameBoy.CPU
+
+	c.expect("qSupported:swbreak+", "PacketSize=1000;QStartNoAckMode+")
+
+	c.expect("s", "S05")
+	if cpu.PC != 0x0200 {
+		t.Errorf("step error: want PC 0x0200, got %#04x", cpu.PC)
+	}
+
+	c.expect("Z0,103,1", "OK")
+	c.expect("c", "S05")
+	if cpu.PC != 0x0103 || cpu.B != 1 {
+		t.Errorf("continue error: want PC 0x0103 B 1, got PC %#04x B %d", cpu.PC, cpu.B)
+	}
+
+	// continuing from the breakpoint stops at it the next time around
+	c.expect("c", "S05")
+	if cpu.PC != 0x0103 || cpu.B != 2 {
+		t.Errorf("continue error: want PC 0x0103 B 2, got PC %#04x B %d", cpu.PC, cpu.B)
+	}
+
+	c.expect("z0,103,1", "OK")
+
+	fmt.Fprintf(c.conn, "$c#%02x", checksum("c"))
+	if ack, err := c.reader.ReadByte(); err != nil || ack != '+' {
+		t.Fatalf("c ack error: got %q (%v)", ack, err)
+	}
+
+	c.conn.Write([]byte{interruptByte})
+	if reply := c.reply(); reply != "S02" {
+		t.Errorf("interrupt error: want S02, got %q", reply)
+	}
+
+	c.expect("D", "OK")
+	if err := <-errs; err != nil {
+		t.Errorf("serve error: %v", err)
+	}
+}
+
+func TestServer_NoAck(t *testing.T) {
+	c, _, _ := newTestClient(t)
+	defer c.conn.Close()
+
+	c.expect("QStartNoAckMode", "OK")
+
+	fmt.Fprintf(c.conn, "$p5#%02x", checksum("p5"))
+	if reply := c.reply(); reply != "0001" {
+		t.Errorf("no ack error: want 0001, got %q", reply)
+	}
+}
@@ -0,0 +1,286 @@
+package golden
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+)
+
+const (
+	CompareExact   = "exact"
+	ComparePalette = "palette"
+
+	DefaultFrames = 60
+)
+
+// MismatchError is returned by Check when the screenshot differs from the reference.
+type MismatchError struct {
+	Reference  string
+	Frames     int
+	Mismatches int
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("golden: %d pixels differ from %s after %d frames", e.Mismatches, e.Reference, e.Frames)
+}
+
+// Input holds the buttons pressed from Frame on.
+type Input struct {
+	Frame   int
+	Buttons joypad.Buttons
+}
+
+// Script describes how a rom is run before taking the screenshot.
+type Script struct {
+	Frames  int
+	Compare string
+	Input   []Input
+}
+
+// ParseScript parses a script, one directive per line:
+//
+//	# comment
+//	frames 300
+//	compare palette
+//	120 start
+//	125 -
+func ParseScript(r io.Reader) (*Script, error) {
+	s := &Script{
+		Frames:  DefaultFrames,
+		Compare: CompareExact,
+	}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = strings.TrimSpace(text[:i])
+		}
+
+		if text == "" {
+			continue
+		}
+
+		fields := strings.SplitN(text, " ", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("golden: line %d: invalid directive %q", line, text)
+		}
+
+		arg := strings.TrimSpace(fields[1])
+
+		switch fields[0] {
+		case "frames":
+			frames, err := strconv.Atoi(arg)
+			if err != nil {
+				return nil, fmt.Errorf("golden: line %d: %v", line, err)
+			}
+
+			s.Frames = frames
+		case "compare":
+			if arg != CompareExact && arg != ComparePalette {
+				return nil, fmt.Errorf("golden: line %d: invalid comparison %s", line, arg)
+			}
+
+			s.Compare = arg
+		default:
+			frame, err := strconv.Atoi(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("golden: line %d: invalid directive %q", line, text)
+			}
+
+			buttons, err := joypad.ParseButtons(arg)
+			if err != nil {
+				return nil, fmt.Errorf("golden: line %d: %v", line, err)
+			}
+
+			s.Input = append(s.Input, Input{Frame: frame, Buttons: buttons})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(s.Input, func(i, j int) bool {
+		return s.Input[i].Frame < s.Input[j].Frame
+	})
+
+	return s, nil
+}
+
+// Screenshot runs the rom for the frames of the script, pressing its buttons,
+// and returns a copy of the last frame.
+func Screenshot(gb *gameboy.GameBoy, s *Script) (*image.RGBA, error) {
+	input := 0
+
+	for frame := 0; frame < s.Frames; frame++ {
+		for input < len(s.Input) && s.Input[input].Frame <= frame {
+			gb.Hardware.Joypad.Pressed = s.Input[input].Buttons
+			input++
+		}
+
+		if err := gb.RunFrame(); err != nil {
+			return nil, fmt.Errorf("golden: frame %d: %v", frame, err)
+		}
+	}
+
+	img := image.NewRGBA(gb.Hardware.Display.Image.Bounds())
+	draw.Draw(img, img.Bounds(), gb.Hardware.Display.Image, image.ZP, draw.Src)
+
+	return img, nil
+}
+
+// Shade maps a color to the game boy shade (0 white - 3 black) closest to its
+// luminance, so images using different palettes can be compared.
+func Shade(c color.Color) uint8 {
+	y := color.GrayModel.Convert(c).(color.Gray).Y
+
+	return 3 - uint8((int(y)*3+127)/255)
+}
+
+// Compare compares two images and returns the number of different pixels and
+// an image of the differences, marked in red over the reference.
+func Compare(actual image.Image, reference image.Image, mode string) (int, *image.RGBA) {
+	bounds := reference.Bounds()
+	diff := image.NewRGBA(bounds)
+	mismatches := 0
+
+	if actual.Bounds().Size() != bounds.Size() {
+		draw.Draw(diff, bounds, image.NewUniform(color.RGBA{R: 255, A: 255}), image.ZP, draw.Src)
+		return bounds.Dx() * bounds.Dy(), diff
+	}
+
+	offset := actual.Bounds().Min.Sub(bounds.Min)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			want := reference.At(x, y)
+			got := actual.At(x+offset.X, y+offset.Y)
+
+			var equal bool
+			if mode == ComparePalette {
+				equal = Shade(want) == Shade(got)
+			} else {
+				equal = color.RGBAModel.Convert(want) == color.RGBAModel.Convert(got)
+			}
+
+			if equal {
+				gray := color.GrayModel.Convert(want).(color.Gray).Y/4 + 192
+				diff.SetRGBA(x, y, color.RGBA{R: gray, G: gray, B: gray, A: 255})
+			} else {
+				diff.SetRGBA(x, y, color.RGBA{R: 255, A: 255})
+				mismatches++
+			}
+		}
+	}
+
+	return mismatches, diff
+}
+
+func LoadPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return png.Decode(file)
+}
+
+func SavePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = png.Encode(file, img)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Check runs the rom at romPath with the script <rom>.input, if present, and
+// compares the screenshot with the reference <rom>.png. On mismatch the
+// screenshot and the differences are written next to the reference as
+// <rom>.actual.png and <rom>.diff.png.
+func Check(romPath string, bootrom []uint8) error {
+	base := strings.TrimSuffix(romPath, filepath.Ext(romPath))
+
+	s := &Script{
+		Frames:  DefaultFrames,
+		Compare: CompareExact,
+	}
+
+	file, err := os.Open(base + ".input")
+	if err == nil {
+		s, err = ParseScript(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	reference, err := LoadPNG(base + ".png")
+	if err != nil {
+		return err
+	}
+
+	rom, err := ioutil.ReadFile(romPath)
+	if err != nil {
+		return err
+	}
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{
+		Bootrom:   bootrom,
+		Cartridge: rom,
+	})
+	if err != nil {
+		return err
+	}
+
+	actual, err := Screenshot(gb, s)
+	if err != nil {
+		return err
+	}
+
+	mismatches, diff := Compare(actual, reference, s.Compare)
+	if mismatches == 0 {
+		return nil
+	}
+
+	if err := SavePNG(base+".actual.png", actual); err != nil {
+		return err
+	}
+
+	if err := SavePNG(base+".diff.png", diff); err != nil {
+		return err
+	}
+
+	return &MismatchError{
+		Reference:  base + ".png",
+		Frames:     s.Frames,
+		Mismatches: mismatches,
+	}
+}
@@ -0,0 +1,81 @@
+package golden
+
+import (
+	"image"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+)
+
+// TestGolden checks every rom in testdata against its reference screenshot,
+// see Check for the files used.
+func TestGolden(t *testing.T) {
+	roms, err := filepath.Glob(filepath.Join("testdata", "*.gb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(roms) == 0 {
+		t.Skip("no roms in testdata")
+	}
+
+	for _, rom := range roms {
+		rom := rom
+
+		t.Run(filepath.Base(rom), func(t *testing.T) {
+			t.Parallel()
+
+			if err := Check(rom, nil); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestParseScript(t *testing.T) {
+	s, err := ParseScript(strings.NewReader("# menu\nframes 300\ncompare palette\n\n125 -\n120 start # open\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Frames != 300 || s.Compare != ComparePalette {
+		t.Errorf("script error: frames %d, compare %s", s.Frames, s.Compare)
+	}
+
+	want := []Input{{120, joypad.ButtonStart}, {125, 0}}
+	if len(s.Input) != len(want) || s.Input[0] != want[0] || s.Input[1] != want[1] {
+		t.Errorf("input error: want %v, got %v", want, s.Input)
+	}
+
+	if _, err := ParseScript(strings.NewReader("10 turbo")); err == nil {
+		t.Error("invalid input error: want error, got nil")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	gray := image.NewRGBA(image.Rect(0, 0, 4, 1))
+	green := image.NewRGBA(image.Rect(0, 0, 4, 1))
+
+	for i := 0; i < 4; i++ {
+		gray.SetRGBA(i, 0, display.GrayShades[i])
+		green.SetRGBA(i, 0, display.GreenLCDShades[i])
+	}
+
+	if mismatches, _ := Compare(green, gray, ComparePalette); mismatches != 0 {
+		t.Errorf("palette mismatches error: want %d, got %d", 0, mismatches)
+	}
+
+	if mismatches, _ := Compare(green, gray, CompareExact); mismatches != 4 {
+		t.Errorf("exact mismatches error: want %d, got %d", 4, mismatches)
+	}
+
+	green.SetRGBA(1, 0, display.GreenLCDShades[3])
+
+	mismatches, diff := Compare(green, gray, ComparePalette)
+	if mismatches != 1 || diff.RGBAAt(1, 0).R != 255 || diff.RGBAAt(1, 0).G != 0 {
+		t.Errorf("diff error: mismatches %d, pixel %v", mismatches, diff.RGBAAt(1, 0))
+	}
+}
@@ -1,12 +1,47 @@
 package audio
 
+import (
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+// FrameSequencerCycles is the period of the frame sequencer (512Hz)
+const FrameSequencerCycles = 8192
+
 type Audio struct {
+	// FrameSequencerStep is the next step of the frame sequencer, 0 to 7
+	FrameSequencerStep uint8
+
+	scheduler *scheduler.Scheduler
 }
 
-func NewAudio() *Audio {
-	return &Audio{}
+func NewAudio(s *scheduler.Scheduler) *Audio {
+	a := &Audio{
+		scheduler: s,
+	}
+
+	s.Handle(scheduler.EventFrameSequencer, a.stepFrameSequencer)
+	s.Schedule(scheduler.EventFrameSequencer, FrameSequencerCycles)
+
+	return a
 }
 
 func (a *Audio) Emulate() {
 
 }
+
+func (a *Audio) stepFrameSequencer(late int) {
+	// todo length counters on even steps, sweep on steps 2 and 6, volume
+	// envelopes on step 7
+
+	a.FrameSequencerStep = (a.FrameSequencerStep + 1) & 0x7
+
+	a.scheduler.Schedule(scheduler.EventFrameSequencer, FrameSequencerCycles-late)
+}
+
+// SerializeState saves the frame sequencer. The NRxx and wave registers are
+// plain io registers, saved with the io memory in the MEM chunk, the channels
+// have no state of their own yet.
+func (a *Audio) SerializeState(s *state.Chunk) {
+	s.Uint8(&a.FrameSequencerStep)
+}
@@ -1,5 +1,11 @@
 package bootrom
 
+import (
+	"fmt"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
 const (
 	Start = 0x0000
 	End   = 0x00ff
@@ -15,12 +21,20 @@ func NewBootrom() *Bootrom {
 	return &Bootrom{}
 }
 
-func (b *Bootrom) Load(data []uint8) {
-	for i := Start; i <= End; i++ {
-		b.Data[i] = data[i]
+func (b *Bootrom) Load(data []uint8) error {
+	if len(data) != Size {
+		return fmt.Errorf("bootrom: invalid size %d", len(data))
 	}
+
+	copy(b.Data[:], data)
+
+	return nil
 }
 
 func (b *Bootrom) Read(addr uint16) uint8 {
 	return b.Data[addr]
 }
+
+func (b *Bootrom) SerializeState(s *state.Chunk) {
+	s.Bool(&b.Enabled)
+}
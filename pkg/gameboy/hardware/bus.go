@@ -0,0 +1,125 @@
+package hardware
+
+import (
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/bootrom"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/cartridge"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+)
+
+const (
+	// PageSize is the size of the pages of the memory map, the bus looks up
+	// the page of an address instead of comparing it to every region
+	PageSize  = 0x100
+	PageCount = DATA_SIZE / PageSize
+)
+
+// MapMemory maps the pages of plain memory: rom banks, video ram, cartridge
+// ram, work ram and its echo. It must be called again when the cartridge
+// switches banks.
+//
+// The first page, overlaid by the bootrom, the oam page and the io page go
+// to the handlers.
+func (h *Hardware) MapMemory() {
+	h.readPages = [PageCount][]uint8{}
+	h.writePages = [PageCount][]uint8{}
+
+	for addr := bootrom.End + 1; addr <= cartridge.End; addr += PageSize {
+		h.readPages[addr/PageSize] = h.Cartrdige.Page(uint16(addr))
+	}
+
+	for addr := display.Start; addr <= display.End; addr += PageSize {
+		h.mapPage(uint16(addr), h.Display.Page(uint16(addr)))
+	}
+
+	for addr := cartridge.RamStart; addr <= cartridge.RamEnd; addr += PageSize {
+		h.mapPage(uint16(addr), h.Cartrdige.Page(uint16(addr)))
+	}
+
+	for addr := WorkRamBank0Start; addr <= WorkRamBank0End; addr += PageSize {
+		h.mapPage(uint16(addr), h.WorkRamBank0[addr-WorkRamBank0Start:addr-WorkRamBank0Start+PageSize])
+	}
+
+	for addr := WorkRamBankNStart; addr <= WorkRamBankNEnd; addr += PageSize {
+		h.mapPage(uint16(addr), h.WorkRamBankN[addr-WorkRamBankNStart:addr-WorkRamBankNStart+PageSize])
+	}
+
+	for addr := EchoStart; addr <= EchoEnd; addr += PageSize {
+		echoWRamAddr := addr - EchoStart + WorkRamBank0Start
+		h.mapPage(uint16(addr), h.readPages[echoWRamAddr/PageSize])
+	}
+}
+
+func (h *Hardware) mapPage(addr uint16, page []uint8) {
+	h.readPages[addr/PageSize] = page
+	h.writePages[addr/PageSize] = page
+}
+
+// Peek reads memory without checking the watchpoints, it's small enough to be
+// inlined in Read and Fetch.
+func (h *Hardware) Peek(addr uint16) uint8 {
+	if page := h.readPages[addr/PageSize]; page != nil {
+		return page[uint8(addr)]
+	}
+
+	return h.readHandler(addr)
+}
+
+func (h *Hardware) write(addr uint16, val uint8) {
+	if page := h.writePages[addr/PageSize]; page != nil {
+		page[uint8(addr)] = val
+		return
+	}
+
+	h.writeHandler(addr, val)
+}
+
+// readHandler reads the addresses outside of the pages mapped.
+func (h *Hardware) readHandler(addr uint16) uint8 {
+	switch {
+	case addr >= bootrom.Start && addr <= bootrom.End:
+		if h.Bootrom.Enabled {
+			return h.Bootrom.Read(addr)
+		}
+
+		return h.Cartrdige.Read(addr)
+	case addr >= display.OamStart && addr <= display.OamEnd:
+		return h.Display.Read(addr)
+	case addr >= NotUsableStart && addr <= NotUsableEnd:
+		h.Log.Logf(logger.Memory, logger.Debug, "reading not usable (%#04x)", addr)
+		return 0xff
+	case addr >= IoStart && addr <= IoEnd:
+		return h.readIo(addr)
+	case addr >= HighRamStart && addr <= HighRamEnd:
+		return h.HighRam[addr-HighRamStart]
+	case addr == IO_IE:
+		return h.InterruptEnable
+	default:
+		h.Log.Logf(logger.Memory, logger.Warn, "reading unmapped (%#04x)", addr)
+		return 0xff
+	}
+}
+
+// writeHandler writes the addresses outside of the pages mapped, the writes
+// to the rom go to the cartridge mapper.
+func (h *Hardware) writeHandler(addr uint16, val uint8) {
+	switch {
+	case addr >= cartridge.Start && addr <= cartridge.End:
+		if h.Cartrdige.WriteMapper(addr, val) {
+			h.MapMemory()
+		}
+	case addr >= display.OamStart && addr <= display.OamEnd:
+		h.Display.Write(addr, val)
+	case addr >= NotUsableStart && addr <= NotUsableEnd:
+		h.Log.Logf(logger.Memory, logger.Warn, "writing not usable (%#04x) %#02x", addr, val)
+	case addr >= IoStart && addr <= IoEnd:
+		h.writeIo(addr, val)
+	case addr >= HighRamStart && addr <= HighRamEnd:
+		h.HighRam[addr-HighRamStart] = val
+	case addr == IO_IE:
+		// todo interrupts
+		h.InterruptEnable = val
+	default:
+		h.Log.Logf(logger.Memory, logger.Warn, "writing unmapped (%#04x) %#02x", addr, val)
+	}
+}
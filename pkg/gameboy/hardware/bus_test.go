@@ -0,0 +1,90 @@
+package hardware
+
+import "testing"
+
+// busAddrs are spread over the memory map as a game accesses it, mostly rom
+// and work ram.
+var busAddrs = []uint16{
+	0x0150, 0x0151, 0x2a40, 0x4000, 0x5c12, 0x7ffe,
+	0x8010, 0x9800, 0x9c20,
+	0xa000,
+	0xc000, 0xc0a0, 0xc800, 0xd000, 0xdfff, 0xe010,
+	0xfe00, 0xff44, 0xff80, 0xfffe,
+}
+
+func TestHardware_Pages(t *testing.T) {
+	h := NewHardware()
+	h.Cartrdige.Bank[0x0150] = 0x11
+	h.Cartrdige.SwitchableBank0[0x1c12] = 0x22
+	h.Bootrom.Data[0x00] = 0x33
+	h.Bootrom.Enabled = true
+
+	h.Write(0x0150, 0x99)
+	h.Write(0x9c20, 0x44)
+	h.Write(0xa010, 0x55)
+	h.Write(0xe010, 0x66)
+	h.Write(0xfe00, 0x77)
+	h.Write(0xff80, 0x88)
+
+	want := map[uint16]uint8{
+		0x0000: 0x33,
+		0x0150: 0x11,
+		0x5c12: 0x22,
+		0x9c20: 0x44,
+		0xa010: 0x55,
+		0xc010: 0x66,
+		0xe010: 0x66,
+		0xfe00: 0x77,
+		0xfea0: 0xff,
+		0xff80: 0x88,
+	}
+
+	for addr, val := range want {
+		if got := h.Read(addr); got != val {
+			t.Errorf("%#04x error: want %#02x, got %#02x", addr, val, got)
+		}
+	}
+
+	if h.Display.WindowMap[0x20] != 0x44 || h.Cartrdige.Ram[0x10] != 0x55 {
+		t.Error("components error: writes not in the component memory")
+	}
+}
+
+func TestHardware_Bank(t *testing.T) {
+	h := NewHardware()
+
+	want := map[uint16]int{
+		0x0150: 0,
+		0x4000: 1,
+		0xc000: 0,
+		0xd000: 1,
+		0xdfff: 1,
+		0xe000: 0,
+		0xff80: 0,
+	}
+
+	for addr, bank := range want {
+		if got := h.Bank(addr); got != bank {
+			t.Errorf("%#04x error: want bank %d, got %d", addr, bank, got)
+		}
+	}
+}
+
+func BenchmarkHardware_ReadMixed(b *testing.B) {
+	h := NewHardware()
+
+	for i := 0; i < b.N; i++ {
+		h.Read(busAddrs[i%len(busAddrs)])
+	}
+}
+
+func BenchmarkHardware_WriteMixed(b *testing.B) {
+	h := NewHardware()
+
+	for i := 0; i < b.N; i++ {
+		addr := busAddrs[i%len(busAddrs)]
+		if addr >= 0x8000 && addr != 0xff44 {
+			h.Write(addr, uint8(i))
+		}
+	}
+}
@@ -3,6 +3,9 @@ package cartridge
 import (
 	"bytes"
 	"fmt"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
 )
 
 const (
@@ -27,15 +30,24 @@ type Cartridge struct {
 	SwitchableBank0 [SwitchableBankSize]uint8
 	Ram             [RamSize]uint8
 	Title           string
+	Log             logger.Logger
 }
 
 func NewCartridge() *Cartridge {
-	crt := &Cartridge{}
+	crt := &Cartridge{
+		Log: logger.Discard,
+	}
 
 	return crt
 }
 
-func (c *Cartridge) Load(data []uint8) {
+// Load loads a rom, it returns an error if the rom is too short or uses a
+// memory bank controller not implemented.
+func (c *Cartridge) Load(data []uint8) error {
+	if len(data) < End+1 {
+		return fmt.Errorf("cartridge: invalid rom size %d", len(data))
+	}
+
 	titleVal := data[0x0134:0x0143]
 	cgbFlag := data[0x0143]
 	sgbFlag := data[0x0146]
@@ -45,18 +57,18 @@ func (c *Cartridge) Load(data []uint8) {
 
 	c.Title = string(bytes.Trim(titleVal, "\x00"))
 
-	fmt.Printf("cartridge: title %s, cgb %#02x, sgb %#02x, type %#02x, rom %#02x, ram %#02x\n", c.Title, cgbFlag, sgbFlag, carType, romSize, ramSize)
+	c.Log.Logf(logger.Cartridge, logger.Info, "title %s, cgb %#02x, sgb %#02x, type %#02x, rom %#02x, ram %#02x", c.Title, cgbFlag, sgbFlag, carType, romSize, ramSize)
 
 	if carType != 0 {
-		panic(fmt.Errorf("cartridge: unimplemented type %#02x", carType))
+		return fmt.Errorf("cartridge: unimplemented type %#02x", carType)
 	}
 
 	if romSize != 0 {
-		panic(fmt.Errorf("cartridge: unimplemented rom %#02x", romSize))
+		return fmt.Errorf("cartridge: unimplemented rom %#02x", romSize)
 	}
 
 	if ramSize != 0 {
-		panic(fmt.Errorf("cartridge: unimplemented ram %#02x", ramSize))
+		return fmt.Errorf("cartridge: unimplemented ram %#02x", ramSize)
 	}
 
 	for i := BankStart; i <= BankEnd; i++ {
@@ -66,6 +78,43 @@ func (c *Cartridge) Load(data []uint8) {
 	for i := SwitchableBankStart; i <= SwitchableBankEnd; i++ {
 		c.SwitchableBank0[i-SwitchableBankStart] = data[i]
 	}
+
+	return nil
+}
+
+// CurrentBank returns the rom bank mapped at SwitchableBankStart, always 1
+// without a memory bank controller.
+func (c *Cartridge) CurrentBank() int {
+	return 1
+}
+
+// PageSize is the size of the pages returned by Page.
+const PageSize = 0x100
+
+// Page returns the page of rom or ram mapped at addr, the bus reads it
+// directly until the banks are switched.
+func (c *Cartridge) Page(addr uint16) []uint8 {
+	start := int(addr &^ (PageSize - 1))
+
+	switch {
+	case addr >= BankStart && addr <= BankEnd:
+		return c.Bank[start-BankStart : start-BankStart+PageSize]
+	case addr >= SwitchableBankStart && addr <= SwitchableBankEnd:
+		return c.SwitchableBank0[start-SwitchableBankStart : start-SwitchableBankStart+PageSize]
+	case addr >= RamStart && addr <= RamEnd:
+		return c.Ram[start-RamStart : start-RamStart+PageSize]
+	default:
+		return nil
+	}
+}
+
+// WriteMapper writes the memory bank controller registers in the rom area, it
+// returns true if the banks mapped changed.
+func (c *Cartridge) WriteMapper(addr uint16, val uint8) bool {
+	// todo memory bank controllers
+	c.Log.Logf(logger.Cartridge, logger.Debug, "writing mapper (%#04x) %#02x", addr, val)
+
+	return false
 }
 
 func (c *Cartridge) Read(addr uint16) uint8 {
@@ -77,6 +126,21 @@ func (c *Cartridge) Read(addr uint16) uint8 {
 	case addr >= RamStart && addr <= RamEnd:
 		return c.Ram[addr-RamStart]
 	default:
-		panic(fmt.Errorf("cartridge: invalid address %#04x", addr))
+		c.Log.Logf(logger.Cartridge, logger.Error, "reading invalid address (%#04x)", addr)
+		return 0xff
+	}
+}
+
+func (c *Cartridge) Write(addr uint16, val uint8) {
+	switch {
+	case addr >= RamStart && addr <= RamEnd:
+		c.Ram[addr-RamStart] = val
+	default:
+		c.Log.Logf(logger.Cartridge, logger.Error, "writing invalid address (%#04x)", addr)
 	}
 }
+
+// SerializeState saves the mapper state, the rom is not part of it.
+func (c *Cartridge) SerializeState(s *state.Chunk) {
+	s.Bytes(c.Ram[:])
+}
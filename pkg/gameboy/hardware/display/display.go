@@ -1,9 +1,10 @@
 package display
 
 import (
-	"errors"
-	"fmt"
 	"github.com/adnsio/gbemu/pkg/gameboy/bits"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
 	"image"
 	"image/color"
 )
@@ -31,6 +32,15 @@ const (
 	Width  = 160
 	Height = 144
 
+	// Lines is the number of lines of a frame, vblank included
+	Lines = 154
+	// LineCycles is the duration of a line, split in the oam search (mode 2),
+	// the transfer to the lcd (mode 3) and the hblank (mode 0)
+	LineCycles      = 456
+	OamSearchCycles = 80
+	TransferCycles  = 172
+	HBlankCycles    = LineCycles - OamSearchCycles - TransferCycles
+
 	ControlBackgroundEnabled             = 0
 	ControlSpriteEnabled                 = 1
 	ControlSpriteSizeSelect              = 2
@@ -90,12 +100,123 @@ type Display struct {
 	BackgroundMap     [BackgroundMapSize]uint8
 	WindowMap         [WindowMapSize]uint8
 	Oam               [OamSize]uint8
+	Log               logger.Logger
+
+	scheduler *scheduler.Scheduler
 }
 
-func NewDisplay() *Display {
-	return &Display{
+func NewDisplay(s *scheduler.Scheduler) *Display {
+	d := &Display{
 		Image:        image.NewRGBA(image.Rect(0, 0, Width, Height)),
 		ShadesOfGray: GrayShades,
+		Log:          logger.Discard,
+		scheduler:    s,
+	}
+
+	s.Handle(scheduler.EventPPU, d.nextMode)
+
+	return d
+}
+
+// WriteControl turns the display on and off, the first line starts when it's
+// turned on.
+func (d *Display) WriteControl(val uint8) {
+	wasEnabled := bits.Test(d.Control, ControlDisplayEnabled)
+	d.Control = val
+
+	if !bits.Test(d.Control, ControlDisplayEnabled) {
+		if wasEnabled {
+			d.Log.Logf(logger.PPU, logger.Debug, "display off at line %d", d.CurrentLine)
+		}
+
+		d.scheduler.Cancel(scheduler.EventPPU)
+		d.CurrentLine = 0
+		d.setMode(1)
+		return
+	}
+
+	if !wasEnabled {
+		d.Log.Logf(logger.PPU, logger.Debug, "display on")
+
+		d.CurrentLine = 0
+		d.compareLine()
+		d.setMode(2)
+		d.scheduler.Schedule(scheduler.EventPPU, OamSearchCycles)
+	}
+}
+
+// WriteStatus writes the interrupt selection, the mode and the coincidence
+// flag are read only.
+func (d *Display) WriteStatus(val uint8) {
+	d.Status = d.Status&0x7 | val&^0x7
+}
+
+func (d *Display) WriteCompareLine(val uint8) {
+	d.CompareLine = val
+	d.compareLine()
+}
+
+func (d *Display) setMode(mode uint8) {
+	d.Status = d.Status&^0x3 | mode
+}
+
+func (d *Display) compareLine() {
+	if d.CurrentLine == d.CompareLine {
+		d.Status = bits.Set(d.Status, StatusCoincidenceFlag)
+
+		if bits.Test(d.Status, StatusCoincidenceInterrupt) {
+			// todo request interrupt 1
+		}
+	} else {
+		d.Status = bits.Clear(d.Status, StatusCoincidenceFlag)
+	}
+}
+
+// nextMode is run by the scheduler at the end of every mode.
+func (d *Display) nextMode(late int) {
+	switch d.Status & 0x3 {
+	case 2:
+		d.setMode(3)
+		d.DrawLine()
+		d.scheduler.Schedule(scheduler.EventPPU, TransferCycles-late)
+	case 3:
+		d.setMode(0)
+		// todo hdma transfer
+
+		if bits.Test(d.Status, StatusMode0HBlankInterrupt) {
+			// todo request interrupt 1
+		}
+
+		d.scheduler.Schedule(scheduler.EventPPU, HBlankCycles-late)
+	default:
+		d.CurrentLine++
+		if d.CurrentLine == Lines {
+			d.CurrentLine = 0
+		}
+
+		d.compareLine()
+
+		if d.CurrentLine >= Height {
+			if d.CurrentLine == Height {
+				d.setMode(1)
+				// todo request interrupt 0
+
+				if bits.Test(d.Status, StatusMode1VBlankInterrupt) {
+					// todo request interrupt 1
+				}
+			}
+
+			d.scheduler.Schedule(scheduler.EventPPU, LineCycles-late)
+			return
+		}
+
+		d.setMode(2)
+
+		if bits.Test(d.Status, StatusMode2OamInterrupt) {
+			// todo request interrupt 1
+		}
+
+		d.scheduler.Schedule(scheduler.EventPPU, OamSearchCycles-late)
 	}
 }
 
@@ -137,19 +258,10 @@ func (dpl *Display) ReadPaletteShade(pal uint8, color uint8) uint8 {
 	return shade
 }*/
 
+// ReadBackgroundPalette returns the shade of a color, only its 2 low bits are
+// used.
 func (d *Display) ReadBackgroundPalette(val uint8) uint8 {
-	switch val {
-	case 0:
-		return d.BackgroundPalette >> 0 & 0x3
-	case 1:
-		return d.BackgroundPalette >> 2 & 0x3
-	case 2:
-		return d.BackgroundPalette >> 4 & 0x3
-	case 3:
-		return d.BackgroundPalette >> 6 & 0x3
-	default:
-		panic(errors.New(fmt.Sprintf("display: invalid palette %#02x", val)))
-	}
+	return d.BackgroundPalette >> (val & 0x3 * 2) & 0x3
 }
 
 func (d *Display) DrawLine() {
@@ -201,6 +313,27 @@ func (d *Display) DrawLine() {
 	}
 }
 
+// PageSize is the size of the pages returned by Page.
+const PageSize = 0x100
+
+// Page returns the page of video ram at addr, read and written directly by
+// the bus.
+func (d *Display) Page(addr uint16) []uint8 {
+	start := int(addr &^ (PageSize - 1))
+
+	switch {
+	case addr >= TileDataStart && addr <= TileDataEnd:
+		// todo handle CGB
+		return d.TileDataBank0[start-TileDataStart : start-TileDataStart+PageSize]
+	case addr >= BackgroundMapStart && addr <= BackgroundMapEnd:
+		return d.BackgroundMap[start-BackgroundMapStart : start-BackgroundMapStart+PageSize]
+	case addr >= WindowMapStart && addr <= WindowMapEnd:
+		return d.WindowMap[start-WindowMapStart : start-WindowMapStart+PageSize]
+	default:
+		return nil
+	}
+}
+
 func (d *Display) Write(addr uint16, val uint8) {
 	switch {
 	case addr >= TileDataStart && addr <= TileDataEnd:
@@ -214,7 +347,7 @@ func (d *Display) Write(addr uint16, val uint8) {
 		// todo write only if OAM dma is enabled
 		d.Oam[addr-OamStart] = val
 	default:
-		panic(errors.New(fmt.Sprintf("display: writing invalid address (%#04x)", addr)))
+		d.Log.Logf(logger.PPU, logger.Error, "writing invalid address (%#04x)", addr)
 	}
 }
 
@@ -230,6 +363,27 @@ func (d *Display) Read(addr uint16) uint8 {
 	case addr >= OamStart && addr <= OamEnd:
 		return d.Oam[addr-OamStart]
 	default:
-		panic(errors.New(fmt.Sprintf("display: reading invalid address (%#04x)", addr)))
+		d.Log.Logf(logger.PPU, logger.Error, "reading invalid address (%#04x)", addr)
+		return 0xff
 	}
 }
+
+func (d *Display) SerializeState(s *state.Chunk) {
+	s.Uint8(&d.Control)
+	s.Uint8(&d.Status)
+	s.Uint8(&d.ScrollY)
+	s.Uint8(&d.ScrollX)
+	s.Uint8(&d.CurrentLine)
+	s.Uint8(&d.CompareLine)
+	s.Uint8(&d.WindowY)
+	s.Uint8(&d.WindowX)
+	s.Uint8(&d.BackgroundPalette)
+	s.Uint8(&d.ObjectPalette0)
+	s.Uint8(&d.ObjectPalette1)
+	s.Uint8(&d.DmaTransfer)
+	s.Bytes(d.TileDataBank0[:])
+	s.Bytes(d.TileDataBank1[:])
+	s.Bytes(d.BackgroundMap[:])
+	s.Bytes(d.WindowMap[:])
+	s.Bytes(d.Oam[:])
+}
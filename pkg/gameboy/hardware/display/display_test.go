@@ -2,6 +2,7 @@ package display
 
 import (
 	"github.com/adnsio/gbemu/pkg/gameboy/bits"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
 	"testing"
 )
 
@@ -35,7 +36,7 @@ import (
 }*/
 
 func TestDisplay_DrawLine(t *testing.T) {
-	d := NewDisplay()
+	d := NewDisplay(scheduler.NewScheduler())
 
 	d.Control = bits.Set(d.Control, ControlBackgroundEnabled)
 	d.Control = bits.Set(d.Control, ControlBackgroundAndWindowTileSelect)
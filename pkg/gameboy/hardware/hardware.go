@@ -1,13 +1,16 @@
 package hardware
 
 import (
-	"errors"
-	"fmt"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware/audio"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware/bootrom"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware/cartridge"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/serial"
 	"github.com/adnsio/gbemu/pkg/gameboy/hardware/timer"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
 )
 
 const (
@@ -33,6 +36,7 @@ const (
 
 	IoStart = 0xff00
 	IoEnd   = 0xff7f
+	IoSize  = IoEnd - IoStart + 1
 
 	NotUsableStart = 0xfea0
 	NotUsableEnd   = 0xfeff
@@ -51,9 +55,13 @@ const (
 	WorkRamBankNStart = 0xd000
 	WorkRamBankNEnd   = 0xdfff
 	WorkRamBankNSize  = WorkRamBankNEnd - WorkRamBankNStart + 1
+
+	// DmaCycles is the time taken to copy a byte to the OAM
+	DmaCycles = 4
 )
 
 const (
+	IO_P1              = 0xff00
 	IO_SB              = 0xff01
 	IO_SC              = 0xff02
 	IO_DIV             = 0xff04
@@ -61,134 +69,175 @@ const (
 	IO_TMA             = 0xff06
 	IO_TAC             = 0xff07
 	IO_IF              = 0xff0f
+	IO_NR10            = 0xff10
 	IO_NR11            = 0xff11
 	IO_NR12            = 0xff12
 	IO_NR13            = 0xff13
 	IO_NR14            = 0xff14
+	IO_NR21            = 0xff16
+	IO_NR22            = 0xff17
+	IO_NR23            = 0xff18
+	IO_NR24            = 0xff19
+	IO_NR30            = 0xff1a
+	IO_NR31            = 0xff1b
+	IO_NR32            = 0xff1c
+	IO_NR33            = 0xff1d
+	IO_NR34            = 0xff1e
+	IO_NR41            = 0xff20
+	IO_NR42            = 0xff21
+	IO_NR43            = 0xff22
+	IO_NR44            = 0xff23
 	IO_NR50            = 0xff24
 	IO_NR51            = 0xff25
 	IO_NR52            = 0xff26
+	IO_WAVE_START      = 0xff30
+	IO_WAVE_END        = 0xff3f
 	IO_LCDC            = 0xff40
 	IO_STAT            = 0xff41
 	IO_SCY             = 0xff42
 	IO_SCX             = 0xff43
 	IO_LY              = 0xff44
 	IO_LYC             = 0xff45
+	IO_DMA             = 0xff46
 	IO_BGP             = 0xff47
 	IO_OBP0            = 0xff48
 	IO_OBP1            = 0xff49
+	IO_WY              = 0xff4a
+	IO_WX              = 0xff4b
 	IO_DISABLE_BOOTROM = 0xff50
 	IO_IE              = 0xffff
 )
 
 type Hardware struct {
+	// Scheduler runs the events of the components, it's advanced by the cpu
+	Scheduler *scheduler.Scheduler
 	Bootrom   *bootrom.Bootrom
 	Cartrdige *cartridge.Cartridge
 	Display   *display.Display
 	//Irq           *irq.Irq
 	Timer        *timer.Timer
 	Audio        *audio.Audio
+	Serial       *serial.Serial
+	Joypad       *joypad.Joypad
 	HighRam      [HighRamSize]uint8
 	WorkRamBank0 [WorkRamBank0Size]uint8
 	WorkRamBankN [WorkRamBankNSize]uint8 // CGB
+	// InterruptEnable is the IE register at 0xffff
+	InterruptEnable uint8
+	// io are the io registers not owned by a component, as the sound ones
+	io [IoSize]uint8
+	// InstructionPC is the address of the instruction being executed
+	InstructionPC uint16
+	Log           logger.Logger
 	//EmulationTime int
+
+	// dmaByte is the next byte copied by the OAM DMA
+	dmaByte int
+
+	// readPages and writePages are the memory read and written directly by
+	// the bus, the nil pages go to the handlers
+	readPages  [PageCount][]uint8
+	writePages [PageCount][]uint8
+
+	watches     []watch
+	lastWatchID int
 }
 
 func NewHardware() *Hardware {
-	return &Hardware{
+	sched := scheduler.NewScheduler()
+
+	h := &Hardware{
+		Scheduler: sched,
 		Bootrom:   bootrom.NewBootrom(),
 		Cartrdige: cartridge.NewCartridge(),
-		Display:   display.NewDisplay(),
+		Display:   display.NewDisplay(sched),
 		//Irq:       irq.NewIrq(),
-		Timer: timer.NewTimer(),
-		Audio: audio.NewAudio(),
+		Timer:  timer.NewTimer(sched),
+		Audio:  audio.NewAudio(sched),
+		Serial: serial.NewSerial(sched),
+		Joypad: joypad.NewJoypad(),
+		Log:    logger.Discard,
+	}
+
+	sched.Handle(scheduler.EventDMA, h.copyDma)
+	h.MapMemory()
+
+	return h
+}
+
+// SerializeState saves the memory owned by the hardware, components are saved
+// in their own chunks.
+func (h *Hardware) SerializeState(s *state.Chunk) {
+	s.Bytes(h.HighRam[:])
+	s.Bytes(h.WorkRamBank0[:])
+	s.Bytes(h.WorkRamBankN[:])
+	s.Int(&h.dmaByte)
+	s.Uint8(&h.InterruptEnable)
+	s.Bytes(h.io[:])
+}
+
+// StateEntries returns the hardware components saved in a state.
+func (h *Hardware) StateEntries() []state.Entry {
+	return []state.Entry{
+		{Tag: "SCHD", Serializer: h.Scheduler},
+		{Tag: "MEM ", Serializer: h},
+		{Tag: "BOOT", Serializer: h.Bootrom},
+		{Tag: "CART", Serializer: h.Cartrdige},
+		{Tag: "PPU ", Serializer: h.Display},
+		{Tag: "TIMR", Serializer: h.Timer},
+		{Tag: "APU ", Serializer: h.Audio},
+		{Tag: "SER ", Serializer: h.Serial},
+		{Tag: "JOYP", Serializer: h.Joypad},
+	}
+}
+
+// SetLogger sets the logger of the hardware and of its components.
+func (h *Hardware) SetLogger(l logger.Logger) {
+	h.Log = l
+	h.Cartrdige.Log = l
+	h.Display.Log = l
+	h.Serial.Log = l
+}
+
+// SetSerialSink sets the sink receiving the bytes sent on the serial port.
+func (h *Hardware) SetSerialSink(sink serial.Sink) {
+	h.Serial.Sink = sink
+}
+
+// Bank returns the bank mapped at addr as in the symbol files: the rom bank
+// in the switchable bank, 1 in the work ram bank N of the DMG, 0 elsewhere.
+func (h *Hardware) Bank(addr uint16) int {
+	if addr >= cartridge.SwitchableBankStart && addr <= cartridge.SwitchableBankEnd {
+		return h.Cartrdige.CurrentBank()
 	}
+
+	if addr >= WorkRamBankNStart && addr <= WorkRamBankNEnd {
+		return 1
+	}
+
+	return 0
 }
 
 func (h *Hardware) Read(addr uint16) uint8 {
-	switch {
-	case addr >= bootrom.Start && addr <= bootrom.End:
-		if h.Bootrom.Enabled {
-			return h.Bootrom.Read(addr)
-		} else {
-			return h.Cartrdige.Read(addr)
-		}
-	case addr >= cartridge.Start && addr <= cartridge.End:
-		return h.Cartrdige.Read(addr)
-	case addr >= display.Start && addr <= display.End:
-		return h.Display.Read(addr)
-	case addr >= cartridge.RamStart && addr <= cartridge.RamEnd:
-		return h.Cartrdige.Read(addr)
-	case addr >= WorkRamBank0Start && addr <= WorkRamBank0End:
-		return h.WorkRamBank0[addr-WorkRamBank0Start]
-	case addr >= WorkRamBankNStart && addr <= WorkRamBankNEnd:
-		return h.WorkRamBankN[addr-WorkRamBankNStart]
-	case addr >= EchoStart && addr <= EchoEnd:
-		echoWRamAddr := addr - EchoStart + WorkRamBank0Start
-		return h.Read(echoWRamAddr)
-	case addr >= display.OamStart && addr <= display.OamEnd:
-		return h.Display.Read(addr)
-	case addr >= NotUsableStart && addr <= NotUsableEnd:
-		fmt.Printf("memory: reading not usable (%#04x)\n", addr)
-		return 0
-	case addr >= IoStart && addr <= IoEnd:
-		ioAddr := addr & 0xff
-
-		switch ioAddr {
-		case 0x00:
-			// todo joypad register
-			fmt.Printf("memory: reading joypad register io (%#04x)\n", addr)
-			return 0
-		case 0x01:
-			// todo serial data
-			fmt.Printf("memory: reading serial data io (%#04x)\n", addr)
-			return 0
-		case 0x02:
-			// todo serial control
-			fmt.Printf("memory: reading serial control io (%#04x)\n", addr)
-			return 0
-		case 0x04:
-			return h.Timer.DividerRegister
-		case 0x05:
-			return h.Timer.Counter
-		case 0x06:
-			return h.Timer.Modulo
-		case 0x07:
-			return h.Timer.Control
-		case 0x40:
-			return h.Display.Control
-		case 0x41:
-			return h.Display.Status
-		case 0x42:
-			return h.Display.ScrollY
-		case 0x43:
-			return h.Display.ScrollX
-		case 0x44:
-			return h.Display.CurrentLine
-		case 0x45:
-			return h.Display.CompareLine
-		case 0x46:
-			return h.Display.DmaTransfer
-		case 0x47:
-			return h.Display.BackgroundPalette
-		case 0x48:
-			return h.Display.ObjectPalette0
-		case 0x49:
-			return h.Display.ObjectPalette1
-		case 0x4a:
-			return h.Display.WindowY
-		case 0x4b:
-			return h.Display.WindowX
-		default:
-			//panic(errors.New(fmt.Sprintf("memory: reading invalid io (%#04x)", addr)))
-			fmt.Printf("memory: reading invalid io (%#04x)\n", addr)
-			return 0
-		}
-	case addr >= HighRamStart && addr <= HighRamEnd:
-		return h.HighRam[addr-HighRamStart]
-	default:
-		panic(errors.New(fmt.Sprintf("memory: reading invalid address (%#04x)", addr)))
+	val := h.Peek(addr)
+
+	if h.watches != nil {
+		h.checkWatches(AccessRead, addr, val)
 	}
+
+	return val
+}
+
+// Fetch reads the opcode at addr, checking the execute watchpoints instead of
+// the read ones.
+func (h *Hardware) Fetch(addr uint16) uint8 {
+	val := h.Peek(addr)
+
+	if h.watches != nil {
+		h.checkWatches(AccessExecute, addr, val)
+	}
+
+	return val
 }
 
 func (h *Hardware) Read16(addr uint16) uint16 {
@@ -196,86 +245,20 @@ func (h *Hardware) Read16(addr uint16) uint16 {
 }
 
 func (h *Hardware) Write(addr uint16, val uint8) {
-	switch {
-	case addr >= cartridge.Start && addr <= cartridge.End:
-		fmt.Printf("memory: writing cartridge (%#04x) %#02x\n", addr, val)
-	case addr >= display.Start && addr <= display.End:
-		h.Display.Write(addr, val)
-	case addr >= WorkRamBank0Start && addr <= WorkRamBank0End:
-		h.WorkRamBank0[addr-WorkRamBank0Start] = val
-	case addr >= WorkRamBankNStart && addr <= WorkRamBankNEnd:
-		h.WorkRamBankN[addr-WorkRamBankNStart] = val
-	case addr >= EchoStart && addr <= EchoEnd:
-		echoWRamAddr := addr - EchoStart + WorkRamBank0Start
-		h.Write(echoWRamAddr, val)
-	case addr >= display.OamStart && addr <= display.OamEnd:
-		h.Display.Write(addr, val)
-	case addr >= NotUsableStart && addr <= NotUsableEnd:
-		fmt.Printf("memory: writing not usable (%#04x) %#02x\n", addr, val)
-	case addr >= IoStart && addr <= IoEnd:
-		ioAddr := addr & 0xff
-
-		switch ioAddr {
-		case 0x00:
-			// todo joypad register
-			fmt.Printf("memory: writing joypad register io (%#04x)\n", addr)
-		case 0x01:
-			// todo serial data
-			fmt.Printf("memory: writing serial data io (%#04x)\n", addr)
-		case 0x02:
-			// todo serial control
-			fmt.Printf("memory: writing serial control io (%#04x)\n", addr)
-		case 0x04:
-			h.Timer.DividerRegister = 0
-		case 0x05:
-			h.Timer.Counter = val
-		case 0x06:
-			h.Timer.Modulo = val
-		case 0x07:
-			h.Timer.Control = val
-		case 0x0f:
-			// todo interrupt flag
-			fmt.Printf("memory: writing interrupt flag io (%#04x)\n", addr)
-		//case ioAddr >= 0x10 && ioAddr <= 0x3f:
-		// todo sound io
-		//	fmt.Printf("memory: writing sound io (%#04x)\n", addr)
-		case 0x40:
-			h.Display.Control = val
-		case 0x41:
-			h.Display.Status = val
-		case 0x42:
-			h.Display.ScrollY = val
-		case 0x43:
-			h.Display.ScrollX = val
-		case 0x44:
-			h.Display.CurrentLine = 0
-		case 0x45:
-			h.Display.CompareLine = val
-		case 0x46:
-			h.Display.DmaTransfer = val
-		case 0x47:
-			h.Display.BackgroundPalette = val
-		case 0x48:
-			h.Display.ObjectPalette0 = val
-		case 0x49:
-			h.Display.ObjectPalette1 = val
-		case 0x4a:
-			h.Display.WindowY = val
-		case 0x4b:
-			h.Display.WindowX = val
-		case 0x50:
-			h.Bootrom.Enabled = false
-		default:
-			//panic(errors.New(fmt.Sprintf("memory: writing invalid io (%#04x)", addr)))
-			fmt.Printf("memory: writing invalid io (%#04x)\n", addr)
-		}
-	case addr >= HighRamStart && addr <= HighRamEnd:
-		h.HighRam[addr-HighRamStart] = val
-	case addr == 0xffff:
-		// todo interrupt enable
-		fmt.Printf("memory: writing interrupt enable (%#04x)\n", addr)
-	default:
-		panic(errors.New(fmt.Sprintf("memory: writing invalid address (%#04x)", addr)))
+	if h.watches != nil {
+		h.checkWatches(AccessWrite, addr, val)
+	}
+
+	h.write(addr, val)
+}
+
+// copyDma copies the next byte of the OAM DMA, a byte every DmaCycles.
+func (h *Hardware) copyDma(late int) {
+	h.Display.Oam[h.dmaByte] = h.Peek(uint16(h.Display.DmaTransfer)<<8 + uint16(h.dmaByte))
+	h.dmaByte++
+
+	if h.dmaByte < display.OamSize {
+		h.Scheduler.Schedule(scheduler.EventDMA, DmaCycles-late)
 	}
 }
 
@@ -0,0 +1,207 @@
+package hardware
+
+import (
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+)
+
+// ioRegister are the masks of an io register, the read bits are always read
+// set and only the write bits are written.
+type ioRegister struct {
+	read  uint8
+	write uint8
+}
+
+// unusedIo is read as 0xff and ignores the writes.
+var unusedIo = ioRegister{read: 0xff, write: 0x00}
+
+var ioRegisters = newIoRegisters()
+
+func newIoRegisters() [IoSize]ioRegister {
+	var regs [IoSize]ioRegister
+	for i := range regs {
+		regs[i] = unusedIo
+	}
+
+	set := func(addr uint16, read, write uint8) {
+		regs[addr-IoStart] = ioRegister{read: read, write: write}
+	}
+
+	set(IO_P1, 0xc0, 0x30)
+	set(IO_SB, 0x00, 0xff)
+	set(IO_SC, 0x7e, 0x81)
+	set(IO_DIV, 0x00, 0xff)
+	set(IO_TIMA, 0x00, 0xff)
+	set(IO_TMA, 0x00, 0xff)
+	set(IO_TAC, 0xf8, 0x07)
+	set(IO_IF, 0xe0, 0x1f)
+
+	set(IO_NR10, 0x80, 0x7f)
+	set(IO_NR11, 0x3f, 0xff)
+	set(IO_NR12, 0x00, 0xff)
+	set(IO_NR13, 0xff, 0xff)
+	set(IO_NR14, 0xbf, 0xc7)
+	set(IO_NR21, 0x3f, 0xff)
+	set(IO_NR22, 0x00, 0xff)
+	set(IO_NR23, 0xff, 0xff)
+	set(IO_NR24, 0xbf, 0xc7)
+	set(IO_NR30, 0x7f, 0x80)
+	set(IO_NR31, 0xff, 0xff)
+	set(IO_NR32, 0x9f, 0x60)
+	set(IO_NR33, 0xff, 0xff)
+	set(IO_NR34, 0xbf, 0xc7)
+	set(IO_NR41, 0xff, 0x3f)
+	set(IO_NR42, 0x00, 0xff)
+	set(IO_NR43, 0x00, 0xff)
+	set(IO_NR44, 0xbf, 0xc0)
+	set(IO_NR50, 0x00, 0xff)
+	set(IO_NR51, 0x00, 0xff)
+	set(IO_NR52, 0x70, 0x80)
+
+	for addr := uint16(IO_WAVE_START); addr <= IO_WAVE_END; addr++ {
+		set(addr, 0x00, 0xff)
+	}
+
+	set(IO_LCDC, 0x00, 0xff)
+	set(IO_STAT, 0x80, 0x78)
+	set(IO_SCY, 0x00, 0xff)
+	set(IO_SCX, 0x00, 0xff)
+	set(IO_LY, 0x00, 0xff)
+	set(IO_LYC, 0x00, 0xff)
+	set(IO_DMA, 0x00, 0xff)
+	set(IO_BGP, 0x00, 0xff)
+	set(IO_OBP0, 0x00, 0xff)
+	set(IO_OBP1, 0x00, 0xff)
+	set(IO_WY, 0x00, 0xff)
+	set(IO_WX, 0x00, 0xff)
+	// the bootrom can't be enabled again, the register is read as 0xff
+	set(IO_DISABLE_BOOTROM, 0xff, 0xff)
+
+	return regs
+}
+
+// readIo reads an io register, the registers without a component are read
+// from the io memory.
+func (h *Hardware) readIo(addr uint16) uint8 {
+	reg := ioRegisters[addr-IoStart]
+
+	var val uint8
+
+	switch addr {
+	case IO_P1:
+		val = h.Joypad.Read()
+	case IO_SB:
+		val = h.Serial.Data
+	case IO_SC:
+		val = h.Serial.Control
+	case IO_DIV:
+		val = h.Timer.DividerRegister
+	case IO_TIMA:
+		val = h.Timer.Counter
+	case IO_TMA:
+		val = h.Timer.Modulo
+	case IO_TAC:
+		val = h.Timer.Control
+	case IO_LCDC:
+		val = h.Display.Control
+	case IO_STAT:
+		val = h.Display.Status
+	case IO_SCY:
+		val = h.Display.ScrollY
+	case IO_SCX:
+		val = h.Display.ScrollX
+	case IO_LY:
+		val = h.Display.CurrentLine
+	case IO_LYC:
+		val = h.Display.CompareLine
+	case IO_DMA:
+		val = h.Display.DmaTransfer
+	case IO_BGP:
+		val = h.Display.BackgroundPalette
+	case IO_OBP0:
+		val = h.Display.ObjectPalette0
+	case IO_OBP1:
+		val = h.Display.ObjectPalette1
+	case IO_WY:
+		val = h.Display.WindowY
+	case IO_WX:
+		val = h.Display.WindowX
+	default:
+		if reg == unusedIo {
+			h.Log.Logf(logger.Memory, logger.Debug, "reading unused io (%#04x)", addr)
+		}
+
+		val = h.io[addr-IoStart]
+	}
+
+	return val | reg.read
+}
+
+// writeIo writes an io register, the registers without a component are
+// written to the io memory.
+func (h *Hardware) writeIo(addr uint16, val uint8) {
+	reg := ioRegisters[addr-IoStart]
+
+	if reg.write == 0 {
+		h.Log.Logf(logger.Memory, logger.Debug, "writing unused io (%#04x) %#02x", addr, val)
+		return
+	}
+
+	val &= reg.write
+
+	switch addr {
+	case IO_P1:
+		h.Joypad.Write(val)
+	case IO_SB:
+		h.Serial.Data = val
+	case IO_SC:
+		h.Serial.WriteControl(val)
+	case IO_DIV:
+		h.Timer.DividerRegister = 0
+	case IO_TIMA:
+		h.Timer.Counter = val
+	case IO_TMA:
+		h.Timer.Modulo = val
+	case IO_TAC:
+		h.Timer.WriteControl(val)
+	case IO_IF:
+		// todo interrupt flag
+		h.Log.Logf(logger.Memory, logger.Debug, "writing interrupt flag io (%#04x) %#02x", addr, val)
+		h.io[addr-IoStart] = val
+	case IO_LCDC:
+		h.Display.WriteControl(val)
+	case IO_STAT:
+		h.Display.WriteStatus(val)
+	case IO_SCY:
+		h.Display.ScrollY = val
+	case IO_SCX:
+		h.Display.ScrollX = val
+	case IO_LY:
+		h.Display.CurrentLine = 0
+	case IO_LYC:
+		h.Display.WriteCompareLine(val)
+	case IO_DMA:
+		h.Display.DmaTransfer = val
+		h.dmaByte = 0
+		h.Scheduler.Schedule(scheduler.EventDMA, DmaCycles)
+	case IO_BGP:
+		h.Display.BackgroundPalette = val
+	case IO_OBP0:
+		h.Display.ObjectPalette0 = val
+	case IO_OBP1:
+		h.Display.ObjectPalette1 = val
+	case IO_WY:
+		h.Display.WindowY = val
+	case IO_WX:
+		h.Display.WindowX = val
+	case IO_DISABLE_BOOTROM:
+		h.Bootrom.Enabled = false
+	default:
+		if addr >= IO_NR10 && addr <= IO_WAVE_END {
+			// todo sound io
+			h.Log.Logf(logger.APU, logger.Debug, "writing sound io (%#04x) %#02x", addr, val)
+		}
+
+		h.io[addr-IoStart] = val
+	}
+}
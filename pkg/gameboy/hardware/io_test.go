@@ -0,0 +1,73 @@
+package hardware
+
+import "testing"
+
+func TestHardware_IoMasks(t *testing.T) {
+	// values read after writing 0x00 and 0xff, the missing registers are
+	// unused and read as 0xff
+	want := map[uint16][2]uint8{
+		IO_P1:   {0xcf, 0xff},
+		IO_SB:   {0x00, 0xff},
+		IO_SC:   {0x7e, 0xff},
+		IO_DIV:  {0x00, 0x00},
+		IO_TIMA: {0x00, 0xff},
+		IO_TMA:  {0x00, 0xff},
+		IO_TAC:  {0xf8, 0xff},
+		IO_IF:   {0xe0, 0xff},
+		IO_NR10: {0x80, 0xff},
+		IO_NR11: {0x3f, 0xff},
+		IO_NR12: {0x00, 0xff},
+		IO_NR14: {0xbf, 0xff},
+		IO_NR21: {0x3f, 0xff},
+		IO_NR22: {0x00, 0xff},
+		IO_NR24: {0xbf, 0xff},
+		IO_NR30: {0x7f, 0xff},
+		IO_NR32: {0x9f, 0xff},
+		IO_NR34: {0xbf, 0xff},
+		IO_NR42: {0x00, 0xff},
+		IO_NR43: {0x00, 0xff},
+		IO_NR44: {0xbf, 0xff},
+		IO_NR50: {0x00, 0xff},
+		IO_NR51: {0x00, 0xff},
+		IO_NR52: {0x70, 0xf0},
+		IO_LCDC: {0x00, 0xff},
+		IO_STAT: {0x80, 0xf8},
+		IO_SCY:  {0x00, 0xff},
+		IO_SCX:  {0x00, 0xff},
+		IO_LY:   {0x00, 0x00},
+		IO_LYC:  {0x00, 0xff},
+		IO_DMA:  {0x00, 0xff},
+		IO_BGP:  {0x00, 0xff},
+		IO_OBP0: {0x00, 0xff},
+		IO_OBP1: {0x00, 0xff},
+		IO_WY:   {0x00, 0xff},
+		IO_WX:   {0x00, 0xff},
+		IO_IE:   {0x00, 0xff},
+	}
+
+	for addr := uint16(IO_WAVE_START); addr <= IO_WAVE_END; addr++ {
+		want[addr] = [2]uint8{0x00, 0xff}
+	}
+
+	addrs := []uint16{IO_IE}
+	for addr := uint16(IoStart); addr <= IoEnd; addr++ {
+		addrs = append(addrs, addr)
+	}
+
+	for _, addr := range addrs {
+		expected, ok := want[addr]
+		if !ok {
+			expected = [2]uint8{0xff, 0xff}
+		}
+
+		h := NewHardware()
+
+		for i, val := range []uint8{0x00, 0xff} {
+			h.Write(addr, val)
+
+			if got := h.Read(addr); got != expected[i] {
+				t.Errorf("%#04x error: wrote %#02x, want %#02x, got %#02x", addr, val, expected[i], got)
+			}
+		}
+	}
+}
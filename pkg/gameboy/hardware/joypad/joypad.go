@@ -0,0 +1,121 @@
+package joypad
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+const (
+	SelectDirections = 4
+	SelectActions    = 5
+)
+
+// Buttons is a set of buttons, directions are in the low nibble and actions
+// in the high one, in the same order they are read from P1.
+type Buttons uint8
+
+const (
+	ButtonRight Buttons = 1 << iota
+	ButtonLeft
+	ButtonUp
+	ButtonDown
+	ButtonA
+	ButtonB
+	ButtonSelect
+	ButtonStart
+)
+
+var buttonNames = []string{"right", "left", "up", "down", "a", "b", "select", "start"}
+
+// ParseButtons parses a list of button names separated by spaces, commas or
+// plus signs (e.g. "a+up"), "-" is no button.
+func ParseButtons(s string) (Buttons, error) {
+	var res Buttons
+
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return r == ' ' || r == ',' || r == '+'
+	})
+
+	for _, field := range fields {
+		if field == "-" {
+			continue
+		}
+
+		found := false
+		for i, name := range buttonNames {
+			if field == name {
+				res |= 1 << uint(i)
+				found = true
+			}
+		}
+
+		if !found {
+			return 0, fmt.Errorf("joypad: invalid button %s", field)
+		}
+	}
+
+	return res, nil
+}
+
+func (b Buttons) String() string {
+	var names []string
+
+	for i, name := range buttonNames {
+		if b&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "-"
+	}
+
+	return strings.Join(names, "+")
+}
+
+type Joypad struct {
+	Select  uint8
+	Pressed Buttons
+}
+
+func NewJoypad() *Joypad {
+	return &Joypad{
+		Select: 0x30,
+	}
+}
+
+func (j *Joypad) Press(b Buttons) {
+	j.Pressed |= b
+	// todo request interrupt 4
+}
+
+func (j *Joypad) Release(b Buttons) {
+	j.Pressed &^= b
+}
+
+// Read returns the P1 register, buttons are active low.
+func (j *Joypad) Read() uint8 {
+	res := uint8(0x0f)
+
+	if j.Select&(1<<SelectDirections) == 0 {
+		res &^= uint8(j.Pressed) & 0x0f
+	}
+
+	if j.Select&(1<<SelectActions) == 0 {
+		res &^= uint8(j.Pressed) >> 4
+	}
+
+	return 0xc0 | j.Select | res
+}
+
+func (j *Joypad) Write(val uint8) {
+	j.Select = val & 0x30
+}
+
+// SerializeState saves the selected buttons group, the pressed buttons are
+// input and not part of the state.
+func (j *Joypad) SerializeState(s *state.Chunk) {
+	s.Uint8(&j.Select)
+}
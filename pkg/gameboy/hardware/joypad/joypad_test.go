@@ -0,0 +1,41 @@
+package joypad
+
+import "testing"
+
+func TestJoypad_Read(t *testing.T) {
+	j := NewJoypad()
+	j.Press(ButtonA | ButtonDown)
+
+	tests := []struct {
+		sel  uint8
+		want uint8
+	}{
+		{0x30, 0xff},
+		{0x20, 0xe7},
+		{0x10, 0xde},
+		{0x00, 0xc6},
+	}
+
+	for _, tt := range tests {
+		j.Write(tt.sel)
+
+		if got := j.Read(); got != tt.want {
+			t.Errorf("select %#02x error: want %#02x, got %#02x", tt.sel, tt.want, got)
+		}
+	}
+}
+
+func TestParseButtons(t *testing.T) {
+	b, err := ParseButtons("start+A, up")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b != ButtonStart|ButtonA|ButtonUp || b.String() != "up+a+start" {
+		t.Errorf("buttons error: got %s", b)
+	}
+
+	if _, err := ParseButtons("turbo"); err == nil {
+		t.Error("invalid button error: want error, got nil")
+	}
+}
@@ -0,0 +1,176 @@
+package serial
+
+import (
+	"sync"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/bits"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+const (
+	ControlShiftClock    = 0
+	ControlTransferStart = 7
+
+	// TransferCycles is the duration of a whole byte transfer with the internal clock (8192Hz)
+	TransferCycles = 8 * 512
+
+	// ClockCycles is the period of the updates of a clock device
+	ClockCycles = 512
+)
+
+// Device is a peripheral connected to the other end of the link cable.
+type Device interface {
+	// Transfer is called when a transfer clocked by the game boy ends, it
+	// receives the byte shifted out and returns the byte shifted in.
+	Transfer(out uint8) uint8
+}
+
+// Clock is implemented by devices that can drive the serial clock themselves,
+// like another game boy acting as master.
+type Clock interface {
+	Update(s *Serial, cycles int)
+}
+
+// Sink receives every byte sent on the serial port, whatever is connected to it.
+type Sink interface {
+	SerialTransmit(val uint8)
+}
+
+type Serial struct {
+	Data      uint8
+	Control   uint8
+	Device    Device
+	Sink      Sink
+	Log       logger.Logger
+	clock     Clock
+	scheduler *scheduler.Scheduler
+}
+
+func NewSerial(sched *scheduler.Scheduler) *Serial {
+	s := &Serial{
+		Log:       logger.Discard,
+		scheduler: sched,
+	}
+
+	sched.Handle(scheduler.EventSerial, s.transfer)
+	sched.Handle(scheduler.EventSerialClock, s.updateClock)
+
+	return s
+}
+
+// Connect plugs a device into the serial port, nil disconnects it.
+func (s *Serial) Connect(dev Device) {
+	s.Device = dev
+	s.clock, _ = dev.(Clock)
+
+	if s.clock != nil {
+		s.scheduler.Schedule(scheduler.EventSerialClock, ClockCycles)
+	} else {
+		s.scheduler.Cancel(scheduler.EventSerialClock)
+	}
+}
+
+// WriteControl writes SC, setting the transfer start bit starts a transfer
+// unless one is already running.
+func (s *Serial) WriteControl(val uint8) {
+	running := bits.Test(s.Control, ControlTransferStart)
+	s.Control = val
+
+	if running || !bits.Test(s.Control, ControlTransferStart) {
+		return
+	}
+
+	if s.Sink != nil {
+		s.Sink.SerialTransmit(s.Data)
+	}
+
+	if bits.Test(s.Control, ControlShiftClock) {
+		s.scheduler.Schedule(scheduler.EventSerial, TransferCycles)
+	}
+}
+
+// transfer ends the transfer clocked by the game boy, once the 8 bits are
+// shifted.
+func (s *Serial) transfer(late int) {
+	if !bits.Test(s.Control, ControlTransferStart) || !bits.Test(s.Control, ControlShiftClock) {
+		return
+	}
+
+	in := uint8(0xff)
+	if s.Device != nil {
+		in = s.Device.Transfer(s.Data)
+	}
+
+	s.complete(in)
+}
+
+func (s *Serial) updateClock(late int) {
+	if s.clock == nil {
+		return
+	}
+
+	s.clock.Update(s, ClockCycles)
+	s.scheduler.Schedule(scheduler.EventSerialClock, ClockCycles-late)
+}
+
+// Receive is used by clock devices to shift a byte in with the external clock,
+// it returns the byte shifted out and false if no transfer was waiting for it.
+func (s *Serial) Receive(in uint8) (uint8, bool) {
+	if !bits.Test(s.Control, ControlTransferStart) || bits.Test(s.Control, ControlShiftClock) {
+		return 0xff, false
+	}
+
+	out := s.Data
+	s.complete(in)
+
+	return out, true
+}
+
+func (s *Serial) complete(in uint8) {
+	s.Log.Logf(logger.Serial, logger.Debug, "transfer out %#02x in %#02x", s.Data, in)
+
+	s.Data = in
+	s.Control = bits.Clear(s.Control, ControlTransferStart)
+	// todo request interrupt 3
+}
+
+func (s *Serial) SerializeState(c *state.Chunk) {
+	c.Uint8(&s.Data)
+	c.Uint8(&s.Control)
+}
+
+// Buffer is a Sink collecting the transmitted bytes, it is safe to read it
+// while the emulation is running.
+type Buffer struct {
+	mutex sync.Mutex
+	data  []uint8
+}
+
+func NewBuffer() *Buffer {
+	return &Buffer{}
+}
+
+func (b *Buffer) SerialTransmit(val uint8) {
+	b.mutex.Lock()
+	b.data = append(b.data, val)
+	b.mutex.Unlock()
+}
+
+func (b *Buffer) Bytes() []uint8 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return append([]uint8(nil), b.data...)
+}
+
+func (b *Buffer) String() string {
+	return string(b.Bytes())
+}
+
+func (b *Buffer) Reset() {
+	b.mutex.Lock()
+	b.data = b.data[:0]
+	b.mutex.Unlock()
+}
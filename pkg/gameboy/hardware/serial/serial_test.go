@@ -0,0 +1,63 @@
+package serial
+
+import (
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+)
+
+func TestSerial_Sink(t *testing.T) {
+	sched := scheduler.NewScheduler()
+	s := NewSerial(sched)
+	buf := NewBuffer()
+	s.Sink = buf
+
+	for _, val := range []uint8("Passed") {
+		s.Data = val
+		s.WriteControl(0x81)
+
+		for s.Control&0x80 != 0 {
+			sched.Advance(4)
+		}
+	}
+
+	// transfers not started are not sent
+	s.Data = '!'
+	s.WriteControl(0x01)
+
+	if buf.String() != "Passed" {
+		t.Errorf("buffer error: want %q, got %q", "Passed", buf.String())
+	}
+
+	if s.Data != '!' {
+		t.Errorf("data error: want %#02x, got %#02x", '!', s.Data)
+	}
+}
+
+func TestSerial_TransferCycles(t *testing.T) {
+	sched := scheduler.NewScheduler()
+	s := NewSerial(sched)
+	buf := NewBuffer()
+	s.Sink = buf
+
+	s.WriteControl(0x81)
+
+	// writing SC during the transfer neither sends the byte again nor
+	// restarts the transfer
+	sched.Advance(TransferCycles / 2)
+	s.WriteControl(0x81)
+
+	if len(buf.Bytes()) != 1 {
+		t.Errorf("sink error: want 1 byte, got %d", len(buf.Bytes()))
+	}
+
+	sched.Advance(TransferCycles/2 - 4)
+	if s.Control != 0x81 {
+		t.Errorf("control error: want %#02x, got %#02x", 0x81, s.Control)
+	}
+
+	sched.Advance(4)
+	if s.Control != 0x01 || s.Data != 0xff {
+		t.Errorf("transfer error: control %#02x, data %#02x", s.Control, s.Data)
+	}
+}
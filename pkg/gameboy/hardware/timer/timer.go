@@ -1,6 +1,10 @@
 package timer
 
-import "github.com/adnsio/gbemu/pkg/gameboy/bits"
+import (
+	"github.com/adnsio/gbemu/pkg/gameboy/bits"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
 
 const (
 	ControlClockSelect0 = 0
@@ -8,46 +12,63 @@ const (
 	ControlEnabled      = 2
 )
 
+// periods are the cycles between counter increments by clock select
+var periods = [4]int{1024, 16, 64, 256}
+
 type Timer struct {
 	Control         uint8
 	Counter         uint8
 	Modulo          uint8
 	DividerRegister uint8
-	InternalCounter int
+
+	scheduler *scheduler.Scheduler
 }
 
-func NewTimer() *Timer {
-	return &Timer{}
+func NewTimer(s *scheduler.Scheduler) *Timer {
+	t := &Timer{
+		scheduler: s,
+	}
+
+	s.Handle(scheduler.EventTimer, t.increment)
+
+	return t
 }
 
-func (t *Timer) Update(cycles int) {
+// WriteControl starts, stops or changes the frequency of the counter.
+func (t *Timer) WriteControl(val uint8) {
 	// todo divider register
 
-	isEnabled := bits.Test(t.Control, ControlEnabled)
-
-	if isEnabled {
-		t.InternalCounter -= cycles
-
-		if t.InternalCounter <= 0 {
-			frequency := t.Counter & 0x3
-
-			switch frequency {
-			case 0:
-				t.InternalCounter = 1024
-			case 1:
-				t.InternalCounter = 16
-			case 2:
-				t.InternalCounter = 64
-			case 3:
-				t.InternalCounter = 256
-			}
-
-			if t.Counter == 255 {
-				t.Counter = t.Modulo
-				// todo request interrupt 2
-			} else {
-				t.Counter++
-			}
-		}
+	changed := t.Control != val
+	t.Control = val
+
+	if !bits.Test(t.Control, ControlEnabled) {
+		t.scheduler.Cancel(scheduler.EventTimer)
+		return
+	}
+
+	if changed || !t.scheduler.Pending(scheduler.EventTimer) {
+		t.scheduler.Schedule(scheduler.EventTimer, t.period())
 	}
 }
+
+func (t *Timer) period() int {
+	return periods[t.Control&0x3]
+}
+
+func (t *Timer) increment(late int) {
+	if t.Counter == 255 {
+		t.Counter = t.Modulo
+		// todo request interrupt 2
+	} else {
+		t.Counter++
+	}
+
+	t.scheduler.Schedule(scheduler.EventTimer, t.period()-late)
+}
+
+func (t *Timer) SerializeState(s *state.Chunk) {
+	s.Uint8(&t.Control)
+	s.Uint8(&t.Counter)
+	s.Uint8(&t.Modulo)
+	s.Uint8(&t.DividerRegister)
+}
@@ -0,0 +1,102 @@
+package hardware
+
+import "fmt"
+
+// Access is a kind of memory access, combined as a bitmask in watchpoints.
+type Access uint8
+
+const (
+	AccessRead Access = 1 << iota
+	AccessWrite
+	AccessExecute
+)
+
+func (a Access) String() string {
+	switch a {
+	case AccessRead:
+		return "read"
+	case AccessWrite:
+		return "write"
+	case AccessExecute:
+		return "execute"
+	default:
+		return fmt.Sprintf("access(%d)", uint8(a))
+	}
+}
+
+// Watchpoint matches the accesses to the addresses from Start to End
+// included, only the ones of Value when HasValue is set.
+type Watchpoint struct {
+	Start    uint16
+	End      uint16
+	Access   Access
+	HasValue bool
+	Value    uint8
+}
+
+func (w *Watchpoint) matches(access Access, addr uint16, val uint8) bool {
+	return w.Access&access != 0 && addr >= w.Start && addr <= w.End && (!w.HasValue || w.Value == val)
+}
+
+// WatchHit describes an access matching a watchpoint, PC and Bank are the
+// ones of the instruction doing it.
+type WatchHit struct {
+	ID     int
+	Access Access
+	Addr   uint16
+	Value  uint8
+	PC     uint16
+	Bank   int
+}
+
+func (h WatchHit) String() string {
+	return fmt.Sprintf("%s %04X = %02X by %02X:%04X", h.Access, h.Addr, h.Value, h.Bank, h.PC)
+}
+
+// WatchHook is called on the accesses matching a watchpoint, before writes
+// take effect.
+type WatchHook func(hit WatchHit)
+
+type watch struct {
+	Watchpoint
+	id   int
+	hook WatchHook
+}
+
+// Watch adds a watchpoint and returns its id. Accesses are checked only while
+// there are watchpoints, so they have no cost otherwise.
+func (h *Hardware) Watch(wp Watchpoint, hook WatchHook) int {
+	h.lastWatchID++
+	h.watches = append(h.watches, watch{Watchpoint: wp, id: h.lastWatchID, hook: hook})
+
+	return h.lastWatchID
+}
+
+// Unwatch removes the watchpoint with the given id.
+func (h *Hardware) Unwatch(id int) {
+	for i, w := range h.watches {
+		if w.id == id {
+			h.watches = append(h.watches[:i], h.watches[i+1:]...)
+			break
+		}
+	}
+
+	if len(h.watches) == 0 {
+		h.watches = nil
+	}
+}
+
+func (h *Hardware) checkWatches(access Access, addr uint16, val uint8) {
+	for _, w := range h.watches {
+		if w.matches(access, addr, val) {
+			w.hook(WatchHit{
+				ID:     w.id,
+				Access: access,
+				Addr:   addr,
+				Value:  val,
+				PC:     h.InstructionPC,
+				Bank:   h.Bank(h.InstructionPC),
+			})
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package hardware
+
+import "testing"
+
+func TestHardware_Watch(t *testing.T) {
+	h := NewHardware()
+	h.InstructionPC = 0x4123
+
+	var hits []WatchHit
+	hook := func(hit WatchHit) {
+		hits = append(hits, hit)
+	}
+
+	id := h.Watch(Watchpoint{Start: 0xc000, End: 0xc00f, Access: AccessWrite}, hook)
+	h.Watch(Watchpoint{Start: 0xc010, End: 0xc010, Access: AccessRead | AccessWrite, HasValue: true, Value: 0x42}, hook)
+
+	h.Write(0xc005, 0x01)
+	h.Read(0xc005)
+	h.Write(0xc010, 0x01)
+	h.Write(0xc010, 0x42)
+	h.Read(0xc010)
+	h.Peek(0xc010)
+
+	want := []WatchHit{
+		{ID: id, Access: AccessWrite, Addr: 0xc005, Value: 0x01, PC: 0x4123, Bank: 1},
+		{ID: id + 1, Access: AccessWrite, Addr: 0xc010, Value: 0x42, PC: 0x4123, Bank: 1},
+		{ID: id + 1, Access: AccessRead, Addr: 0xc010, Value: 0x42, PC: 0x4123, Bank: 1},
+	}
+
+	if len(hits) != len(want) {
+		t.Fatalf("hits error: want %v, got %v", want, hits)
+	}
+
+	for i := range want {
+		if hits[i] != want[i] {
+			t.Errorf("hit %d error: want %v, got %v", i, want[i], hits[i])
+		}
+	}
+
+	h.Unwatch(id)
+	h.Unwatch(id + 1)
+
+	if h.watches != nil {
+		t.Error("unwatch error: watches left")
+	}
+}
+
+func BenchmarkHardware_Read(b *testing.B) {
+	h := NewHardware()
+
+	for i := 0; i < b.N; i++ {
+		h.Read(WorkRamBank0Start + uint16(i&0xfff))
+	}
+}
+
+func BenchmarkHardware_ReadWatched(b *testing.B) {
+	h := NewHardware()
+	h.Watch(Watchpoint{Start: 0xff80, End: 0xff80, Access: AccessRead}, func(hit WatchHit) {})
+
+	for i := 0; i < b.N; i++ {
+		h.Read(WorkRamBank0Start + uint16(i&0xfff))
+	}
+}
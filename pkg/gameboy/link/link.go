@@ -0,0 +1,169 @@
+package link
+
+import (
+	"bufio"
+	"io"
+	"net"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/serial"
+)
+
+const (
+	// DefaultSliceCycles is the amount of cycles both emulators run before waiting for each other
+	DefaultSliceCycles = serial.TransferCycles
+
+	msgSync     = 0x01
+	msgTransfer = 0x02
+	msgReply    = 0x03
+)
+
+// Link is a link cable between two emulators over a network connection.
+//
+// The two sides run in lockstep: after every time slice each side sends a sync
+// message and waits for the one of the peer, so a transfer started by the
+// master is always received by the slave within the same slice. Once the
+// connection is lost every transfer reads 0xff, like an unplugged cable.
+type Link struct {
+	SliceCycles  int
+	conn         net.Conn
+	reader       *bufio.Reader
+	writer       *bufio.Writer
+	cycles       int
+	pendingSyncs int
+	closed       bool
+}
+
+func NewLink(conn net.Conn) *Link {
+	return &Link{
+		SliceCycles: DefaultSliceCycles,
+		conn:        conn,
+		reader:      bufio.NewReader(conn),
+		writer:      bufio.NewWriter(conn),
+	}
+}
+
+// Listen waits for the first emulator connecting to addr.
+func Listen(addr string) (*Link, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer ln.Close()
+
+	conn, err := ln.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLink(conn), nil
+}
+
+// Dial connects to an emulator listening on addr.
+func Dial(addr string) (*Link, error) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLink(conn), nil
+}
+
+func (l *Link) Close() error {
+	l.closed = true
+	return l.conn.Close()
+}
+
+// Transfer sends a byte clocked by this side and waits for the peer reply.
+func (l *Link) Transfer(out uint8) uint8 {
+	if l.closed || !l.send(msgTransfer, out) {
+		return 0xff
+	}
+
+	for {
+		msg, val, ok := l.receive()
+		if !ok {
+			return 0xff
+		}
+
+		switch msg {
+		case msgReply:
+			return val
+		case msgSync:
+			l.pendingSyncs++
+		case msgTransfer:
+			// both sides are using the internal clock, nobody is listening
+			if !l.send(msgReply, 0xff) {
+				return 0xff
+			}
+		}
+	}
+}
+
+// Update ends the current time slice when due, serving the transfers clocked
+// by the peer until it reaches the same point.
+func (l *Link) Update(s *serial.Serial, cycles int) {
+	if l.closed {
+		return
+	}
+
+	l.cycles += cycles
+	if l.cycles < l.SliceCycles {
+		return
+	}
+	l.cycles -= l.SliceCycles
+
+	if !l.send(msgSync, 0) {
+		return
+	}
+
+	for l.pendingSyncs == 0 {
+		msg, val, ok := l.receive()
+		if !ok {
+			return
+		}
+
+		switch msg {
+		case msgSync:
+			l.pendingSyncs++
+		case msgTransfer:
+			out, _ := s.Receive(val)
+			if !l.send(msgReply, out) {
+				return
+			}
+		}
+	}
+
+	l.pendingSyncs--
+}
+
+func (l *Link) send(msg uint8, val uint8) bool {
+	_, err := l.writer.Write([]uint8{msg, val})
+	if err == nil {
+		err = l.writer.Flush()
+	}
+
+	if err != nil {
+		l.disconnect()
+		return false
+	}
+
+	return true
+}
+
+func (l *Link) receive() (uint8, uint8, bool) {
+	var buf [2]uint8
+
+	_, err := io.ReadFull(l.reader, buf[:])
+	if err != nil {
+		l.disconnect()
+		return 0, 0, false
+	}
+
+	return buf[0], buf[1], true
+}
+
+func (l *Link) disconnect() {
+	if !l.closed {
+		l.Close()
+	}
+}
@@ -0,0 +1,100 @@
+package link
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/serial"
+	"github.com/adnsio/gbemu/pkg/gameboy/scheduler"
+)
+
+func newTestLinks(t *testing.T) (*Link, *Link) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			t.Error(err)
+		}
+		accepted <- conn
+	}()
+
+	master, err := Dial(ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return master, NewLink(<-accepted)
+}
+
+func runScheduler(s *scheduler.Scheduler, cycles int, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for i := 0; i < cycles; i += 4 {
+		s.Advance(4)
+	}
+}
+
+func TestLink_Transfer(t *testing.T) {
+	masterLink, slaveLink := newTestLinks(t)
+	defer masterLink.Close()
+	defer slaveLink.Close()
+
+	masterScheduler := scheduler.NewScheduler()
+	master := serial.NewSerial(masterScheduler)
+	master.Connect(masterLink)
+	master.Data = 0x42
+
+	slaveScheduler := scheduler.NewScheduler()
+	slave := serial.NewSerial(slaveScheduler)
+	slave.Connect(slaveLink)
+	slave.Data = 0x99
+	slave.WriteControl(0x80)
+
+	master.WriteControl(0x81)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go runScheduler(masterScheduler, 4*DefaultSliceCycles, wg)
+	go runScheduler(slaveScheduler, 4*DefaultSliceCycles, wg)
+	wg.Wait()
+
+	if master.Data != 0x99 {
+		t.Errorf("master data error: want %#02x, got %#02x", 0x99, master.Data)
+	}
+
+	if slave.Data != 0x42 {
+		t.Errorf("slave data error: want %#02x, got %#02x", 0x42, slave.Data)
+	}
+
+	if master.Control != 0x01 || slave.Control != 0x00 {
+		t.Errorf("control error: master %#02x, slave %#02x", master.Control, slave.Control)
+	}
+}
+
+func TestLink_Disconnected(t *testing.T) {
+	masterLink, slaveLink := newTestLinks(t)
+	defer masterLink.Close()
+
+	slaveLink.Close()
+
+	sched := scheduler.NewScheduler()
+	master := serial.NewSerial(sched)
+	master.Connect(masterLink)
+	master.Data = 0x42
+	master.WriteControl(0x81)
+
+	for i := 0; i < 2*DefaultSliceCycles; i += 4 {
+		sched.Advance(4)
+	}
+
+	if master.Data != 0xff {
+		t.Errorf("data error: want %#02x, got %#02x", 0xff, master.Data)
+	}
+}
@@ -0,0 +1,215 @@
+// Package logger defines the logger used by the emulator core, messages are
+// tagged with the subsystem sending them and a level.
+package logger
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Subsystem int
+
+const (
+	CPU Subsystem = iota
+	Memory
+	PPU
+	APU
+	Cartridge
+	Serial
+	// GameBoy is the emulator itself, as the rewind snapshots
+	GameBoy
+
+	subsystemCount
+)
+
+var subsystemNames = [subsystemCount]string{"cpu", "mem", "ppu", "apu", "cart", "serial", "gb"}
+
+func (s Subsystem) String() string {
+	if s < 0 || s >= subsystemCount {
+		return fmt.Sprintf("subsystem(%d)", int(s))
+	}
+
+	return subsystemNames[s]
+}
+
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+
+	return levelNames[l]
+}
+
+// Logger receives the messages of the emulator core. Implementations should
+// check the subsystem and the level before formatting, the core logs from
+// the memory accesses.
+type Logger interface {
+	Logf(sub Subsystem, level Level, format string, args ...interface{})
+}
+
+type discard struct{}
+
+func (discard) Logf(sub Subsystem, level Level, format string, args ...interface{}) {}
+
+// Discard is the default logger, it's silent.
+var Discard Logger = discard{}
+
+// Filter is the lowest level logged by subsystem, the subsystems missing are
+// silent.
+type Filter map[Subsystem]Level
+
+// ParseFilter parses a comma separated list of subsystems, each optionally
+// followed by the lowest level logged, info by default, as "mem,cart:debug".
+// The subsystem all sets every subsystem.
+func ParseFilter(text string) (Filter, error) {
+	f := make(Filter)
+
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, levelName := item, "info"
+		if i := strings.IndexByte(item, ':'); i >= 0 {
+			name, levelName = item[:i], item[i+1:]
+		}
+
+		level, err := parseLevel(levelName)
+		if err != nil {
+			return nil, err
+		}
+
+		if name == "all" {
+			for s := Subsystem(0); s < subsystemCount; s++ {
+				f[s] = level
+			}
+
+			continue
+		}
+
+		sub, err := parseSubsystem(name)
+		if err != nil {
+			return nil, err
+		}
+
+		f[sub] = level
+	}
+
+	return f, nil
+}
+
+func parseSubsystem(name string) (Subsystem, error) {
+	for s, n := range subsystemNames {
+		if n == name {
+			return Subsystem(s), nil
+		}
+	}
+
+	return 0, fmt.Errorf("logger: invalid subsystem %q (want one of %s or all)", name, strings.Join(subsystemNames[:], ", "))
+}
+
+func parseLevel(name string) (Level, error) {
+	for l, n := range levelNames {
+		if n == name {
+			return Level(l), nil
+		}
+	}
+
+	return 0, fmt.Errorf("logger: invalid level %q (want one of %s)", name, strings.Join(levelNames, ", "))
+}
+
+const (
+	// DefaultBurst is the number of times the same message is written in an
+	// interval before being suppressed
+	DefaultBurst = 5
+	// DefaultInterval is the duration of the rate limiting intervals
+	DefaultInterval = time.Second
+)
+
+// Writer is a Logger writing the messages allowed by its filter as lines.
+// Repeated messages are rate limited, the number of messages suppressed is
+// written when the interval ends.
+type Writer struct {
+	Filter   Filter
+	Burst    int
+	Interval time.Duration
+
+	mutex  sync.Mutex
+	w      io.Writer
+	now    func() time.Time
+	start  time.Time
+	counts map[string]int
+}
+
+func NewWriter(w io.Writer, filter Filter) *Writer {
+	return &Writer{
+		Filter:   filter,
+		Burst:    DefaultBurst,
+		Interval: DefaultInterval,
+		w:        w,
+		now:      time.Now,
+		counts:   make(map[string]int),
+	}
+}
+
+func (w *Writer) Logf(sub Subsystem, level Level, format string, args ...interface{}) {
+	min, ok := w.Filter[sub]
+	if !ok || level < min {
+		return
+	}
+
+	line := fmt.Sprintf("%s: %s: %s", sub, level, fmt.Sprintf(format, args...))
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if now := w.now(); now.Sub(w.start) >= w.Interval {
+		w.flush()
+		w.start = now
+	}
+
+	w.counts[line]++
+	if w.counts[line] <= w.Burst {
+		fmt.Fprintln(w.w, line)
+	}
+}
+
+// Flush writes the number of messages suppressed in the current interval.
+func (w *Writer) Flush() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.flush()
+}
+
+func (w *Writer) flush() {
+	var lines []string
+	for line, count := range w.counts {
+		if count > w.Burst {
+			lines = append(lines, fmt.Sprintf("%s (%d more suppressed)", line, count-w.Burst))
+		}
+	}
+	sort.Strings(lines)
+
+	for _, line := range lines {
+		fmt.Fprintln(w.w, line)
+	}
+
+	w.counts = make(map[string]int)
+}
@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := ParseFilter("mem, cart:debug")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Filter{Memory: Info, Cartridge: Debug}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("filter error: want %v, got %v", want, f)
+	}
+
+	f, err = ParseFilter("all:warn,cpu:error")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f) != int(subsystemCount) || f[PPU] != Warn || f[CPU] != Error {
+		t.Errorf("all filter error: got %v", f)
+	}
+
+	for _, text := range []string{"gpu", "mem:verbose"} {
+		if _, err := ParseFilter(text); err == nil {
+			t.Errorf("%q error: want error, got nil", text)
+		}
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	now := time.Unix(0, 0)
+	w := NewWriter(&buf, Filter{Memory: Warn, Cartridge: Debug})
+	w.Burst = 2
+	w.now = func() time.Time { return now }
+
+	w.Logf(Memory, Info, "below the level")
+	w.Logf(PPU, Error, "subsystem silent")
+	w.Logf(Cartridge, Debug, "title %s", "TETRIS")
+
+	for i := 0; i < 5; i++ {
+		w.Logf(Memory, Warn, "reading invalid io (%#04x)", 0xff4d)
+	}
+
+	now = now.Add(DefaultInterval)
+	w.Logf(Memory, Warn, "reading invalid io (%#04x)", 0xff4d)
+
+	want := []string{
+		"cart: debug: title TETRIS",
+		"mem: warn: reading invalid io (0xff4d)",
+		"mem: warn: reading invalid io (0xff4d)",
+		"mem: warn: reading invalid io (0xff4d) (3 more suppressed)",
+		"mem: warn: reading invalid io (0xff4d)",
+	}
+
+	got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lines error: want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,321 @@
+package movie
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+const (
+	Magic   = "GBMV"
+	Version = 1
+
+	StartPowerOn   = 0
+	StartSaveState = 1
+
+	// DefaultHashInterval is the number of frames between two frame hashes
+	DefaultHashInterval = 60
+)
+
+// Header is the beginning of a movie file:
+//
+//	magic "GBMV", version uint16
+//	rom sha1 [20]uint8
+//	start uint8, followed by state length uint32 and state for StartSaveState
+//	hash interval uint32
+//	bootrom sha1 [20]uint8, zero without the bootrom
+//
+// then every frame is the joypad bitmask, followed by the frame hash
+// (uint64, fnv-1a of the save state) every hash interval frames.
+type Header struct {
+	ROMHash      [sha1.Size]uint8
+	StartType    uint8
+	State        []uint8
+	HashInterval int
+	// BootromHash is the hash of the bootrom when the movie starts through
+	// it, zero otherwise
+	BootromHash [sha1.Size]uint8
+}
+
+// DesyncError is returned when a frame hash differs from the recorded one.
+type DesyncError struct {
+	Frame int
+}
+
+func (e *DesyncError) Error() string {
+	return fmt.Sprintf("movie: desync at frame %d", e.Frame)
+}
+
+// bootromHash returns the hash of the bootrom of gb if it's running it.
+func bootromHash(gb *gameboy.GameBoy) [sha1.Size]uint8 {
+	if !gb.Hardware.Bootrom.Enabled {
+		return [sha1.Size]uint8{}
+	}
+
+	return sha1.Sum(gb.Hardware.Bootrom.Data[:])
+}
+
+// FrameHash returns the hash of the whole game boy state.
+func FrameHash(gb *gameboy.GameBoy) (uint64, error) {
+	h := fnv.New64a()
+
+	err := gb.SaveState(h)
+	if err != nil {
+		return 0, err
+	}
+
+	return h.Sum64(), nil
+}
+
+type Recorder struct {
+	Header
+	writer *bufio.Writer
+	frame  int
+}
+
+// NewRecorder starts recording a movie of gb, from its current state when
+// fromState is set or from power-on otherwise.
+func NewRecorder(w io.Writer, gb *gameboy.GameBoy, rom []uint8, fromState bool) (*Recorder, error) {
+	r := &Recorder{
+		Header: Header{
+			ROMHash:      sha1.Sum(rom),
+			StartType:    StartPowerOn,
+			HashInterval: DefaultHashInterval,
+		},
+		writer: bufio.NewWriter(w),
+	}
+
+	if fromState {
+		var buf bytes.Buffer
+		if err := gb.SaveState(&buf); err != nil {
+			return nil, err
+		}
+
+		r.StartType = StartSaveState
+		r.State = buf.Bytes()
+	}
+
+	r.BootromHash = bootromHash(gb)
+
+	if err := writeHeader(r.writer, &r.Header); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Frame runs a frame and records the buttons pressed during it.
+func (r *Recorder) Frame(gb *gameboy.GameBoy) error {
+	err := gb.RunFrame()
+	if err != nil {
+		return err
+	}
+
+	r.frame++
+
+	err = r.writer.WriteByte(uint8(gb.Hardware.Joypad.Pressed))
+	if err != nil {
+		return err
+	}
+
+	if r.frame%r.HashInterval == 0 {
+		hash, err := FrameHash(gb)
+		if err != nil {
+			return err
+		}
+
+		err = binary.Write(r.writer, binary.LittleEndian, hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes the buffered frames, it must be called when recording ends.
+func (r *Recorder) Flush() error {
+	return r.writer.Flush()
+}
+
+type Player struct {
+	Header
+	// Desync is the first frame with a hash different from the recorded one, 0 if none
+	Desync int
+	reader *bufio.Reader
+	frame  int
+}
+
+// NewPlayer reads the header of a movie.
+func NewPlayer(r io.Reader) (*Player, error) {
+	p := &Player{
+		reader: bufio.NewReader(r),
+	}
+
+	if err := readHeader(p.reader, &p.Header); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Start checks the rom and restores the start state of the movie, then
+// checks that the bootrom runs as when recording.
+func (p *Player) Start(gb *gameboy.GameBoy, rom []uint8) error {
+	if sha1.Sum(rom) != p.ROMHash {
+		return errors.New("movie: recorded with a different rom")
+	}
+
+	if p.StartType == StartSaveState {
+		if err := gb.LoadState(bytes.NewReader(p.State)); err != nil {
+			return err
+		}
+	}
+
+	if hash := bootromHash(gb); hash != p.BootromHash {
+		switch {
+		case p.BootromHash == [sha1.Size]uint8{}:
+			return errors.New("movie: recorded without the bootrom")
+		case hash == [sha1.Size]uint8{}:
+			return errors.New("movie: recorded with the bootrom")
+		default:
+			return errors.New("movie: recorded with a different bootrom")
+		}
+	}
+
+	return nil
+}
+
+// Frame runs a frame with the recorded buttons, it returns io.EOF at the end
+// of the movie and a *DesyncError the first time a frame hash differs.
+func (p *Player) Frame(gb *gameboy.GameBoy) error {
+	buttons, err := p.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	gb.Hardware.Joypad.Pressed = joypad.Buttons(buttons)
+	if err := gb.RunFrame(); err != nil {
+		return err
+	}
+
+	p.frame++
+
+	if p.frame%p.HashInterval != 0 {
+		return nil
+	}
+
+	var want uint64
+	if err := binary.Read(p.reader, binary.LittleEndian, &want); err != nil {
+		return err
+	}
+
+	got, err := FrameHash(gb)
+	if err != nil {
+		return err
+	}
+
+	if got != want && p.Desync == 0 {
+		p.Desync = p.frame
+		return &DesyncError{Frame: p.frame}
+	}
+
+	return nil
+}
+
+func writeHeader(w io.Writer, h *Header) error {
+	var buf bytes.Buffer
+
+	buf.WriteString(Magic)
+	binary.Write(&buf, binary.LittleEndian, uint16(Version))
+	buf.Write(h.ROMHash[:])
+	buf.WriteByte(h.StartType)
+
+	if h.StartType == StartSaveState {
+		binary.Write(&buf, binary.LittleEndian, uint32(len(h.State)))
+		buf.Write(h.State)
+	}
+
+	binary.Write(&buf, binary.LittleEndian, uint32(h.HashInterval))
+	buf.Write(h.BootromHash[:])
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+func readHeader(r io.Reader, h *Header) error {
+	var magic [4]uint8
+	var version uint16
+	var interval uint32
+
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+
+	if string(magic[:]) != Magic {
+		return errors.New("movie: invalid magic")
+	}
+
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+
+	if version != Version {
+		return fmt.Errorf("movie: unsupported version %d", version)
+	}
+
+	if _, err := io.ReadFull(r, h.ROMHash[:]); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+
+	start := []uint8{0}
+	if _, err := io.ReadFull(r, start); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+	h.StartType = start[0]
+
+	switch h.StartType {
+	case StartPowerOn:
+	case StartSaveState:
+		var size uint32
+		if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return fmt.Errorf("movie: reading header: %v", err)
+		}
+
+		if size > state.MaxChunkSize {
+			return errors.New("movie: invalid start state size")
+		}
+
+		h.State = make([]uint8, size)
+		if _, err := io.ReadFull(r, h.State); err != nil {
+			return fmt.Errorf("movie: reading start state: %v", err)
+		}
+	default:
+		return fmt.Errorf("movie: invalid start %d", h.StartType)
+	}
+
+	if err := binary.Read(r, binary.LittleEndian, &interval); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+
+	if interval == 0 {
+		return errors.New("movie: invalid hash interval")
+	}
+	h.HashInterval = int(interval)
+
+	if _, err := io.ReadFull(r, h.BootromHash[:]); err != nil {
+		return fmt.Errorf("movie: reading header: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package movie
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/joypad"
+)
+
+const testFrames = 2*DefaultHashInterval + 10
+
+// newTestROM returns a rom adding the action buttons to 0xc000 forever.
+func newTestROM() []uint8 {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0x3e, 0x10, 0xe0, 0x00, 0xf0, 0x00, 0x47, 0xfa, 0x00, 0xc0, 0x80, 0xea, 0x00, 0xc0, 0x18, 0xf0})
+
+	return rom
+}
+
+func recordTestMovie(t *testing.T, rom []uint8, fromState bool) []uint8 {
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fromState {
+		gb.RunFrame()
+	}
+
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf, gb, rom, fromState)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < testFrames; i++ {
+		gb.Hardware.Joypad.Pressed = joypad.Buttons(i / 7)
+
+		if err := r.Frame(gb); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := r.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func playTestMovie(t *testing.T, rom []uint8, data []uint8) *Player {
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPlayer(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Start(gb, rom); err != nil {
+		t.Fatal(err)
+	}
+
+	frames := 0
+	for {
+		err := p.Frame(gb)
+		if err == io.EOF {
+			break
+		}
+		if _, ok := err.(*DesyncError); err != nil && !ok {
+			t.Fatal(err)
+		}
+
+		frames++
+	}
+
+	if frames != testFrames {
+		t.Errorf("frames error: want %d, got %d", testFrames, frames)
+	}
+
+	return p
+}
+
+func TestPlayer(t *testing.T) {
+	rom := newTestROM()
+
+	for _, fromState := range []bool{false, true} {
+		data := recordTestMovie(t, rom, fromState)
+
+		if p := playTestMovie(t, rom, data); p.Desync != 0 {
+			t.Errorf("desync error (from state %v): want none, got frame %d", fromState, p.Desync)
+		}
+	}
+}
+
+func TestPlayer_Desync(t *testing.T) {
+	rom := newTestROM()
+	data := recordTestMovie(t, rom, false)
+
+	// change the buttons of the 70th frame, after the first hash
+	headerSize := len(data) - testFrames - 2*8
+	data[headerSize+69+8] ^= uint8(joypad.ButtonA)
+
+	if p := playTestMovie(t, rom, data); p.Desync != 2*DefaultHashInterval {
+		t.Errorf("desync error: want frame %d, got %d", 2*DefaultHashInterval, p.Desync)
+	}
+}
+
+func TestPlayer_DifferentROM(t *testing.T) {
+	rom := newTestROM()
+	data := recordTestMovie(t, rom, false)
+
+	p, err := NewPlayer(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rom[0x0150] = 0xff
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Start(gb, rom); err == nil {
+		t.Error("rom error: want error, got nil")
+	}
+}
+
+func TestPlayer_Bootrom(t *testing.T) {
+	rom := newTestROM()
+	data := recordTestMovie(t, rom, false)
+
+	p, err := NewPlayer(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a bootrom jumping to the cartridge
+	bootrom := make([]uint8, 0x100)
+	copy(bootrom[0xfc:], []uint8{0xe0, 0x50})
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Bootrom: bootrom, Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Start(gb, rom); err == nil {
+		t.Error("bootrom error: want error, got nil")
+	}
+}
+
+func TestNewPlayer_Version(t *testing.T) {
+	data := recordTestMovie(t, newTestROM(), false)
+
+	for _, version := range []uint8{0, Version + 1} {
+		data[4] = version
+
+		if _, err := NewPlayer(bytes.NewReader(data)); err == nil {
+			t.Errorf("version %d error: want error, got nil", version)
+		}
+	}
+}
+
+func TestNewPlayer_StateSize(t *testing.T) {
+	var data bytes.Buffer
+	data.WriteString(Magic)
+	data.Write([]uint8{Version, 0})
+	data.Write(make([]uint8, 20))
+	data.Write([]uint8{StartSaveState, 0xff, 0xff, 0xff, 0xff})
+
+	if _, err := NewPlayer(&data); err == nil || err.Error() != "movie: invalid start state size" {
+		t.Errorf("state size error: got %v", err)
+	}
+}
@@ -0,0 +1,320 @@
+package printer
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/display"
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+)
+
+const (
+	CommandInit   = 0x01
+	CommandPrint  = 0x02
+	CommandData   = 0x04
+	CommandStatus = 0x0f
+
+	StatusChecksumError   = 0
+	StatusPrinting        = 1
+	StatusImageDataFull   = 2
+	StatusUnprocessedData = 3
+
+	Magic1   = 0x88
+	Magic2   = 0x33
+	DeviceID = 0x81
+
+	Width = 160
+	// BufferSize is the printer memory, 9 data packets of 2 tile rows each
+	BufferSize = 9 * 0x280
+	// MarginLines is the height in pixels of a single margin line feed
+	MarginLines = 8
+	// PrintingPolls is the amount of status requests reporting the printer busy after a print
+	PrintingPolls = 4
+
+	tileRowSize = Width / 8 * 16
+)
+
+const (
+	stateMagic1 = iota
+	stateMagic2
+	stateCommand
+	stateCompression
+	stateLengthLo
+	stateLengthHi
+	stateData
+	stateChecksumLo
+	stateChecksumHi
+	stateAlive
+	stateStatus
+)
+
+// Printer emulates the Game Boy Printer on the serial port.
+//
+// Printed images are assembled into strips: a print with no bottom margin is
+// continued by the next one, and the strip is sent to Output when a print
+// ends with a feed.
+type Printer struct {
+	Shades [4]color.RGBA
+	Output func(img *image.RGBA) error
+	// Log receives the errors of Output for the strips sent while printing
+	Log logger.Logger
+
+	state       int
+	command     uint8
+	compression uint8
+	length      uint16
+	checksum    uint16
+	sum         uint16
+	packet      []uint8
+	buffer      []uint8
+	status      uint8
+	printing    int
+	strip       []uint8
+}
+
+// NewPrinter returns a printer saving every strip as a numbered PNG file in dir.
+func NewPrinter(dir string) *Printer {
+	prints := 0
+
+	p := &Printer{
+		Shades: display.GrayShades,
+		Log:    logger.Discard,
+	}
+
+	p.Output = func(img *image.RGBA) error {
+		prints++
+
+		file, err := os.Create(filepath.Join(dir, fmt.Sprintf("print_%04d.png", prints)))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		return png.Encode(file, img)
+	}
+
+	return p
+}
+
+func (p *Printer) Transfer(out uint8) uint8 {
+	in := uint8(0x00)
+
+	switch p.state {
+	case stateMagic1:
+		if out == Magic1 {
+			p.state = stateMagic2
+		}
+	case stateMagic2:
+		if out == Magic2 {
+			p.state = stateCommand
+		} else {
+			p.state = stateMagic1
+		}
+	case stateCommand:
+		p.command = out
+		p.sum = uint16(out)
+		p.state = stateCompression
+	case stateCompression:
+		p.compression = out
+		p.sum += uint16(out)
+		p.state = stateLengthLo
+	case stateLengthLo:
+		p.length = uint16(out)
+		p.sum += uint16(out)
+		p.state = stateLengthHi
+	case stateLengthHi:
+		p.length |= uint16(out) << 8
+		p.sum += uint16(out)
+		p.packet = p.packet[:0]
+
+		if p.length > 0 {
+			p.state = stateData
+		} else {
+			p.state = stateChecksumLo
+		}
+	case stateData:
+		p.packet = append(p.packet, out)
+		p.sum += uint16(out)
+
+		if len(p.packet) == int(p.length) {
+			p.state = stateChecksumLo
+		}
+	case stateChecksumLo:
+		p.checksum = uint16(out)
+		p.state = stateChecksumHi
+	case stateChecksumHi:
+		p.checksum |= uint16(out) << 8
+		p.execute()
+		p.state = stateAlive
+	case stateAlive:
+		in = DeviceID
+		p.state = stateStatus
+	case stateStatus:
+		in = p.status
+
+		if p.command == CommandStatus && p.printing > 0 {
+			p.printing--
+			if p.printing == 0 {
+				p.status &^= 1 << StatusPrinting
+			}
+		}
+
+		p.state = stateMagic1
+	}
+
+	return in
+}
+
+func (p *Printer) execute() {
+	if p.checksum != p.sum {
+		p.status |= 1 << StatusChecksumError
+		return
+	}
+	p.status &^= 1 << StatusChecksumError
+
+	switch p.command {
+	case CommandInit:
+		p.buffer = p.buffer[:0]
+		p.status = 0
+		p.printing = 0
+	case CommandData:
+		data := p.packet
+		if p.compression != 0 {
+			data = decompress(data)
+		}
+
+		p.buffer = append(p.buffer, data...)
+		if len(p.buffer) > BufferSize {
+			p.buffer = p.buffer[:BufferSize]
+		}
+
+		if len(p.buffer) > 0 {
+			p.status |= 1 << StatusUnprocessedData
+		}
+
+		if len(p.buffer) == BufferSize {
+			p.status |= 1 << StatusImageDataFull
+		}
+	case CommandPrint:
+		if len(p.packet) < 4 {
+			return
+		}
+
+		p.print(p.packet[1], p.packet[2])
+
+		p.buffer = p.buffer[:0]
+		p.status &^= 1<<StatusUnprocessedData | 1<<StatusImageDataFull
+		p.status |= 1 << StatusPrinting
+		p.printing = PrintingPolls
+	}
+}
+
+// print renders the buffer, margins are the line feeds before (high nibble)
+// and after (low nibble) the image.
+func (p *Printer) print(margins uint8, palette uint8) {
+	if palette == 0 {
+		palette = 0xe4
+	}
+
+	before := int(margins>>4) * MarginLines
+	after := int(margins&0xf) * MarginLines
+
+	if before > 0 && len(p.strip) > 0 {
+		p.flush()
+	}
+
+	p.feed(before)
+
+	rows := len(p.buffer) / tileRowSize
+	for row := 0; row < rows; row++ {
+		tiles := p.buffer[row*tileRowSize : (row+1)*tileRowSize]
+
+		for line := 0; line < 8; line++ {
+			for x := 0; x < Width; x++ {
+				tile := tiles[x/8*16:]
+				bit := uint8(7 - x%8)
+				colorVal := (tile[line*2+1]>>bit&1)<<1 | tile[line*2]>>bit&1
+
+				p.strip = append(p.strip, palette>>(colorVal*2)&0x3)
+			}
+		}
+	}
+
+	p.feed(after)
+
+	if after > 0 {
+		p.flush()
+	}
+}
+
+func (p *Printer) feed(lines int) {
+	for i := 0; i < lines*Width; i++ {
+		p.strip = append(p.strip, 0)
+	}
+}
+
+// Flush sends the strip being printed to Output, if any.
+func (p *Printer) Flush() error {
+	return p.flush()
+}
+
+func (p *Printer) flush() error {
+	if len(p.strip) == 0 {
+		return nil
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, len(p.strip)/Width))
+	for i, shade := range p.strip {
+		img.SetRGBA(i%Width, i/Width, p.Shades[shade])
+	}
+
+	p.strip = p.strip[:0]
+
+	if p.Output == nil {
+		return nil
+	}
+
+	err := p.Output(img)
+	if err != nil && p.Log != nil {
+		p.Log.Logf(logger.Serial, logger.Error, "printer: %v", err)
+	}
+
+	return err
+}
+
+// decompress expands the run length encoding of data packets: a control byte
+// with bit 7 set repeats the next byte (n & 0x7f) + 2 times, otherwise the
+// next n + 1 bytes are copied as they are.
+func decompress(data []uint8) []uint8 {
+	res := make([]uint8, 0, len(data)*2)
+
+	for i := 0; i < len(data); {
+		ctrl := data[i]
+		i++
+
+		if ctrl&0x80 != 0 {
+			if i >= len(data) {
+				break
+			}
+
+			for n := 0; n < int(ctrl&0x7f)+2; n++ {
+				res = append(res, data[i])
+			}
+			i++
+		} else {
+			end := i + int(ctrl) + 1
+			if end > len(data) {
+				end = len(data)
+			}
+
+			res = append(res, data[i:end]...)
+			i = end
+		}
+	}
+
+	return res
+}
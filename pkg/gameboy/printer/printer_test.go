@@ -0,0 +1,123 @@
+package printer
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+)
+
+func sendPacket(p *Printer, command uint8, compression uint8, data []uint8) (uint8, uint8) {
+	packet := []uint8{Magic1, Magic2, command, compression, uint8(len(data)), uint8(len(data) >> 8)}
+	packet = append(packet, data...)
+
+	sum := uint16(0)
+	for _, val := range packet[2:] {
+		sum += uint16(val)
+	}
+
+	packet = append(packet, uint8(sum), uint8(sum>>8))
+
+	for _, val := range packet {
+		if res := p.Transfer(val); res != 0x00 {
+			return res, 0
+		}
+	}
+
+	return p.Transfer(0x00), p.Transfer(0x00)
+}
+
+func TestPrinter_Print(t *testing.T) {
+	var prints []*image.RGBA
+
+	p := NewPrinter("")
+	p.Output = func(img *image.RGBA) error {
+		prints = append(prints, img)
+		return nil
+	}
+
+	alive, status := sendPacket(p, CommandInit, 0, nil)
+	if alive != DeviceID || status != 0x00 {
+		t.Fatalf("init error: alive %#02x, status %#02x", alive, status)
+	}
+
+	_, status = sendPacket(p, CommandData, 0, nil)
+	if status != 0x00 {
+		t.Errorf("empty data status error: want %#02x, got %#02x", 0x00, status)
+	}
+
+	// two tile rows with every pixel of color 3, in runs of 128 bytes
+	_, status = sendPacket(p, CommandData, 1, []uint8{0xfe, 0xff, 0xfe, 0xff, 0xfe, 0xff, 0xfe, 0xff, 0xfe, 0xff})
+	if status != 1<<StatusUnprocessedData {
+		t.Errorf("data status error: want %#02x, got %#02x", 1<<StatusUnprocessedData, status)
+	}
+
+	_, status = sendPacket(p, CommandPrint, 0, []uint8{0x01, 0x01, 0xe4, 0x40})
+	if status != 1<<StatusPrinting {
+		t.Errorf("print status error: want %#02x, got %#02x", 1<<StatusPrinting, status)
+	}
+
+	if len(prints) != 1 {
+		t.Fatalf("prints error: want %d, got %d", 1, len(prints))
+	}
+
+	bounds := prints[0].Bounds()
+	if bounds.Dx() != Width || bounds.Dy() != 16+MarginLines {
+		t.Errorf("size error: want %dx%d, got %dx%d", Width, 16+MarginLines, bounds.Dx(), bounds.Dy())
+	}
+
+	if prints[0].RGBAAt(0, 0) != p.Shades[3] || prints[0].RGBAAt(0, 16) != p.Shades[0] {
+		t.Errorf("color error: got %v and %v", prints[0].RGBAAt(0, 0), prints[0].RGBAAt(0, 16))
+	}
+
+	for i := 0; i <= PrintingPolls; i++ {
+		_, status = sendPacket(p, CommandStatus, 0, nil)
+	}
+
+	if status != 0x00 {
+		t.Errorf("status error: want %#02x, got %#02x", 0x00, status)
+	}
+}
+
+func TestPrinter_OutputError(t *testing.T) {
+	var log bytes.Buffer
+
+	p := NewPrinter("")
+	p.Log = logger.NewWriter(&log, logger.Filter{logger.Serial: logger.Error})
+	p.Output = func(img *image.RGBA) error {
+		return errors.New("disk full")
+	}
+
+	sendPacket(p, CommandInit, 0, nil)
+	sendPacket(p, CommandData, 0, make([]uint8, tileRowSize))
+	sendPacket(p, CommandPrint, 0, []uint8{0x01, 0x01, 0xe4, 0x40})
+
+	if !strings.Contains(log.String(), "printer: disk full") {
+		t.Errorf("log error: got %q", log.String())
+	}
+}
+
+func TestPrinter_ChecksumError(t *testing.T) {
+	p := NewPrinter("")
+
+	for _, val := range []uint8{Magic1, Magic2, CommandInit, 0, 0, 0, 0xff, 0xff} {
+		p.Transfer(val)
+	}
+
+	alive, status := p.Transfer(0x00), p.Transfer(0x00)
+	if alive != DeviceID || status != 1<<StatusChecksumError {
+		t.Errorf("checksum error: alive %#02x, status %#02x", alive, status)
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	got := decompress([]uint8{0x81, 0xaa, 0x01, 0x01, 0x02})
+	want := []uint8{0xaa, 0xaa, 0xaa, 0x01, 0x02}
+
+	if string(got) != string(want) {
+		t.Errorf("decompress error: want %v, got %v", want, got)
+	}
+}
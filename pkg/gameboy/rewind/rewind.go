@@ -0,0 +1,152 @@
+package rewind
+
+import "encoding/binary"
+
+// Buffer is a ring buffer of snapshots with a bounded depth.
+//
+// Only the newest snapshot is kept as it is, every older one is stored as the
+// run length encoded difference (xor) with the snapshot after it, which is
+// mostly zeros between close frames.
+type Buffer struct {
+	Depth  int
+	last   []uint8
+	deltas [][]uint8
+	start  int
+	count  int
+}
+
+func NewBuffer(depth int) *Buffer {
+	if depth < 1 {
+		depth = 1
+	}
+
+	return &Buffer{
+		Depth:  depth,
+		deltas: make([][]uint8, depth-1),
+	}
+}
+
+// Len returns the number of snapshots in the buffer.
+func (b *Buffer) Len() int {
+	if b.last == nil {
+		return 0
+	}
+
+	return b.count + 1
+}
+
+// Push adds a snapshot, dropping the oldest one when the buffer is full.
+func (b *Buffer) Push(snapshot []uint8) {
+	if b.last != nil && b.Depth > 1 {
+		if b.count == b.Depth-1 {
+			b.start = (b.start + 1) % (b.Depth - 1)
+			b.count--
+		}
+
+		b.deltas[(b.start+b.count)%(b.Depth-1)] = encodeDelta(b.last, snapshot)
+		b.count++
+	}
+
+	b.last = append([]uint8(nil), snapshot...)
+}
+
+// Pop removes and returns the newest snapshot.
+func (b *Buffer) Pop() ([]uint8, bool) {
+	if b.last == nil {
+		return nil, false
+	}
+
+	res := b.last
+
+	if b.count > 0 {
+		i := (b.start + b.count - 1) % (b.Depth - 1)
+		b.last = decodeDelta(b.deltas[i], res)
+		b.deltas[i] = nil
+		b.count--
+	} else {
+		b.last = nil
+	}
+
+	return res, true
+}
+
+// Reset removes all the snapshots.
+func (b *Buffer) Reset() {
+	b.last = nil
+	b.start = 0
+	b.count = 0
+
+	for i := range b.deltas {
+		b.deltas[i] = nil
+	}
+}
+
+// encodeDelta encodes prev as the difference with next: the length of prev
+// followed by pairs of zero bytes count and literal bytes count with the
+// literal bytes, all counts as uvarints.
+func encodeDelta(prev []uint8, next []uint8) []uint8 {
+	res := appendUvarint(nil, uint64(len(prev)))
+
+	xor := func(i int) uint8 {
+		if i < len(next) {
+			return prev[i] ^ next[i]
+		}
+
+		return prev[i]
+	}
+
+	for i := 0; i < len(prev); {
+		zeros := 0
+		for i+zeros < len(prev) && xor(i+zeros) == 0 {
+			zeros++
+		}
+		i += zeros
+
+		literals := 0
+		for i+literals < len(prev) && xor(i+literals) != 0 {
+			literals++
+		}
+
+		res = appendUvarint(res, uint64(zeros))
+		res = appendUvarint(res, uint64(literals))
+
+		for n := 0; n < literals; n++ {
+			res = append(res, xor(i+n))
+		}
+		i += literals
+	}
+
+	return res
+}
+
+func decodeDelta(delta []uint8, next []uint8) []uint8 {
+	size, n := binary.Uvarint(delta)
+	delta = delta[n:]
+
+	res := make([]uint8, size)
+	copy(res, next)
+
+	for i := 0; len(delta) > 0; {
+		zeros, n := binary.Uvarint(delta)
+		delta = delta[n:]
+		literals, n := binary.Uvarint(delta)
+		delta = delta[n:]
+
+		i += int(zeros)
+
+		for _, val := range delta[:literals] {
+			res[i] ^= val
+			i++
+		}
+		delta = delta[literals:]
+	}
+
+	return res
+}
+
+func appendUvarint(buf []uint8, val uint64) []uint8 {
+	var tmp [binary.MaxVarintLen64]uint8
+	n := binary.PutUvarint(tmp[:], val)
+
+	return append(buf, tmp[:n]...)
+}
@@ -0,0 +1,50 @@
+package rewind
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBuffer(t *testing.T) {
+	snapshots := [][]uint8{
+		{0, 0, 0, 0, 0, 0},
+		{0, 1, 0, 0, 0, 0},
+		{0, 1, 2, 3, 0, 0},
+		{9, 1, 2, 3, 0},
+		{9, 1, 2, 3, 0, 0, 7},
+	}
+
+	b := NewBuffer(4)
+	for _, s := range snapshots {
+		b.Push(s)
+	}
+
+	if b.Len() != 4 {
+		t.Errorf("len error: want %d, got %d", 4, b.Len())
+	}
+
+	for i := len(snapshots) - 1; i >= 1; i-- {
+		s, ok := b.Pop()
+		if !ok || !bytes.Equal(s, snapshots[i]) {
+			t.Errorf("snapshot %d error: want %v, got %v", i, snapshots[i], s)
+		}
+	}
+
+	if _, ok := b.Pop(); ok {
+		t.Error("pop error: want empty buffer")
+	}
+}
+
+func TestDelta(t *testing.T) {
+	prev := []uint8{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
+	next := []uint8{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5}
+
+	delta := encodeDelta(prev, next)
+	if len(delta) > 5 {
+		t.Errorf("delta size error: got %d bytes %v", len(delta), delta)
+	}
+
+	if got := decodeDelta(delta, next); !bytes.Equal(got, prev) {
+		t.Errorf("decode error: want %v, got %v", prev, got)
+	}
+}
@@ -0,0 +1,153 @@
+// Package scheduler runs the hardware events when they are due. The cpu
+// advances the time at every memory access, the components schedule their
+// next event instead of being updated at every cycle.
+package scheduler
+
+import (
+	"math"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+// Event identifies a hardware event, every event is pending at most once.
+type Event int
+
+const (
+	// EventPPU is the next display mode change
+	EventPPU Event = iota
+	// EventTimer is the next increment of the timer counter
+	EventTimer
+	// EventFrameSequencer is the next step of the audio frame sequencer
+	EventFrameSequencer
+	// EventSerial is the end of a transfer clocked by the game boy
+	EventSerial
+	// EventSerialClock updates the device clocking the serial port
+	EventSerialClock
+	// EventDMA is the next byte copied by the OAM DMA
+	EventDMA
+
+	eventCount
+)
+
+// Handler services an event, late is the number of cycles elapsed since it
+// was due. Periodic events schedule themselves again from the handler.
+type Handler func(late int)
+
+type entry struct {
+	at      uint64
+	pending bool
+}
+
+type Scheduler struct {
+	// Now is the number of cycles elapsed since power on
+	Now uint64
+
+	events   [eventCount]entry
+	handlers [eventCount]Handler
+	// next is the time of the first pending event
+	next uint64
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		next: math.MaxUint64,
+	}
+}
+
+// Handle sets the handler of an event.
+func (s *Scheduler) Handle(e Event, h Handler) {
+	s.handlers[e] = h
+}
+
+// Schedule sets an event due in the given cycles, replacing the pending one.
+// Negative cycles are in the past, the event runs at the next Advance.
+func (s *Scheduler) Schedule(e Event, cycles int) {
+	at := s.Now + uint64(cycles)
+	if cycles < 0 && uint64(-cycles) > s.Now {
+		at = 0
+	}
+
+	replaced := s.events[e].pending
+	s.events[e] = entry{at: at, pending: true}
+
+	if replaced {
+		// the event may have been the first one and moved later
+		s.update()
+	} else if at < s.next {
+		s.next = at
+	}
+}
+
+// Cancel removes the pending event, if any.
+func (s *Scheduler) Cancel(e Event) {
+	if !s.events[e].pending {
+		return
+	}
+
+	s.events[e].pending = false
+	s.update()
+}
+
+// Pending returns true if the event is scheduled.
+func (s *Scheduler) Pending(e Event) bool {
+	return s.events[e].pending
+}
+
+// Until returns the cycles left before a pending event is due.
+func (s *Scheduler) Until(e Event) int {
+	return int(int64(s.events[e].at - s.Now))
+}
+
+// Advance moves the time forward and runs the events due, in the order they
+// were due.
+func (s *Scheduler) Advance(cycles int) {
+	s.Now += uint64(cycles)
+
+	for s.Now >= s.next {
+		s.runNext()
+	}
+}
+
+func (s *Scheduler) runNext() {
+	first := Event(-1)
+
+	for e := range s.events {
+		if s.events[e].pending && s.events[e].at == s.next {
+			first = Event(e)
+			break
+		}
+	}
+
+	if first < 0 {
+		s.update()
+		return
+	}
+
+	s.events[first].pending = false
+	s.update()
+
+	s.handlers[first](int(s.Now - s.events[first].at))
+}
+
+func (s *Scheduler) update() {
+	s.next = math.MaxUint64
+
+	for _, ev := range s.events {
+		if ev.pending && ev.at < s.next {
+			s.next = ev.at
+		}
+	}
+}
+
+func (s *Scheduler) SerializeState(c *state.Chunk) {
+	c.Uint64(&s.Now)
+
+	for e := range s.events {
+		c.Uint64(&s.events[e].at)
+		c.Bool(&s.events[e].pending)
+	}
+
+	if c.Loading() {
+		s.update()
+	}
+}
@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+type record struct {
+	event Event
+	now   uint64
+	late  int
+}
+
+func newTestScheduler(records *[]record) *Scheduler {
+	s := NewScheduler()
+
+	for e := Event(0); e < eventCount; e++ {
+		e := e
+		s.Handle(e, func(late int) {
+			*records = append(*records, record{e, s.Now, late})
+		})
+	}
+
+	return s
+}
+
+func TestScheduler_Advance(t *testing.T) {
+	var records []record
+	s := newTestScheduler(&records)
+
+	s.Schedule(EventTimer, 10)
+	s.Schedule(EventPPU, 6)
+	s.Schedule(EventDMA, 6)
+	s.Schedule(EventSerial, 20)
+	s.Cancel(EventSerial)
+
+	s.Advance(4)
+	if len(records) != 0 {
+		t.Errorf("early error: got %v", records)
+	}
+
+	s.Advance(8)
+
+	want := []record{{EventPPU, 12, 6}, {EventDMA, 12, 6}, {EventTimer, 12, 2}}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("order error: want %v, got %v", want, records)
+	}
+
+	s.Advance(100)
+	if len(records) != 3 || s.Pending(EventSerial) {
+		t.Errorf("cancel error: got %v", records)
+	}
+}
+
+func TestScheduler_Reschedule(t *testing.T) {
+	var records []record
+	s := newTestScheduler(&records)
+
+	// a timer rate change moves the pending event later
+	s.Schedule(EventTimer, 16)
+	s.Schedule(EventTimer, 1024)
+
+	s.Advance(100)
+	if len(records) != 0 {
+		t.Errorf("moved event error: got %v", records)
+	}
+
+	s.Advance(924)
+
+	want := []record{{EventTimer, 1024, 0}}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("later error: want %v, got %v", want, records)
+	}
+}
+
+func TestScheduler_Periodic(t *testing.T) {
+	s := NewScheduler()
+
+	count := 0
+	s.Handle(EventFrameSequencer, func(late int) {
+		count++
+		s.Schedule(EventFrameSequencer, 8-late)
+	})
+	s.Schedule(EventFrameSequencer, 8)
+
+	// a late event catches up without drifting
+	s.Advance(20)
+	if count != 2 || s.Until(EventFrameSequencer) != 4 {
+		t.Errorf("catch up error: count %d, until %d", count, s.Until(EventFrameSequencer))
+	}
+
+	for i := 0; i < 25; i++ {
+		s.Advance(4)
+	}
+
+	if count != 15 {
+		t.Errorf("period error: want 15, got %d", count)
+	}
+}
+
+func TestScheduler_SerializeState(t *testing.T) {
+	var records []record
+	s := newTestScheduler(&records)
+
+	s.Advance(100)
+	s.Schedule(EventSerialClock, 50)
+
+	var buf bytes.Buffer
+	if err := state.Save(&buf, []state.Entry{{Tag: "SCHD", Serializer: s}}); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := newTestScheduler(&records)
+	if err := state.Load(&buf, []state.Entry{{Tag: "SCHD", Serializer: loaded}}); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded.Advance(50)
+
+	want := []record{{EventSerialClock, 150, 0}}
+	if !reflect.DeepEqual(records, want) {
+		t.Errorf("load error: want %v, got %v", want, records)
+	}
+}
@@ -0,0 +1,85 @@
+package gameboy
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/adnsio/gbemu/pkg/gameboy/logger"
+	"github.com/adnsio/gbemu/pkg/gameboy/state"
+)
+
+func (gb *GameBoy) SerializeState(s *state.Chunk) {
+	s.Int(&gb.Frame)
+	s.Int(&gb.FrameCycles)
+}
+
+func (gb *GameBoy) stateEntries() []state.Entry {
+	entries := []state.Entry{
+		{Tag: "GB  ", Serializer: gb},
+		{Tag: "CPU ", Serializer: gb.CPU},
+	}
+
+	return append(entries, gb.Hardware.StateEntries()...)
+}
+
+// SaveState writes a snapshot of the running game boy.
+func (gb *GameBoy) SaveState(w io.Writer) error {
+	return state.Save(w, gb.stateEntries())
+}
+
+// LoadState restores a snapshot written by SaveState, the same cartridge
+// must be loaded.
+func (gb *GameBoy) LoadState(r io.Reader) error {
+	return state.Load(r, gb.stateEntries())
+}
+
+func (gb *GameBoy) pushRewindSnapshot() {
+	var buf bytes.Buffer
+
+	err := gb.SaveState(&buf)
+	if err != nil {
+		gb.Log.Logf(logger.GameBoy, logger.Error, "rewind snapshot: %v", err)
+		return
+	}
+
+	gb.rewindBuffer.Push(buf.Bytes())
+	gb.rewindCurrent = true
+}
+
+// Rewind goes back in time by the given number of frames, rounded to the
+// rewind interval, or as far as the rewind buffer allows.
+func (gb *GameBoy) Rewind(frames int) error {
+	if gb.rewindBuffer == nil {
+		return errors.New("gameboy: rewind disabled")
+	}
+
+	steps := frames / gb.rewindInterval
+	if steps < 1 {
+		steps = 1
+	}
+
+	if gb.rewindCurrent {
+		steps++
+	}
+
+	var snapshot []uint8
+
+	for i := 0; i < steps; i++ {
+		s, ok := gb.rewindBuffer.Pop()
+		if !ok {
+			break
+		}
+
+		snapshot = s
+	}
+
+	if snapshot == nil {
+		return errors.New("gameboy: rewind buffer empty")
+	}
+
+	gb.rewindFrames = 0
+	gb.rewindCurrent = false
+
+	return gb.LoadState(bytes.NewReader(snapshot))
+}
@@ -0,0 +1,216 @@
+package state
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	Magic = "GBST"
+	// Version is increased when the meaning of existing fields changes, new
+	// fields are appended to their chunk and don't need a new version
+	Version = 2
+	// MinVersion is the oldest version loaded, the version 1 states have no
+	// scheduler events and the timer and display counters they replaced
+	MinVersion = 2
+	// MaxChunkSize is the largest chunk loaded, above the size of all the
+	// memories of the game boy and the cartridge
+	MaxChunkSize = 1 << 24
+)
+
+// Serializer is implemented by the components saved in a state. The same
+// method is used to save and to load, so fields are always in the same order.
+type Serializer interface {
+	SerializeState(c *Chunk)
+}
+
+// Entry is a component saved in the chunk with the given 4 characters tag.
+type Entry struct {
+	Tag        string
+	Serializer Serializer
+}
+
+// Chunk is the data of a single component.
+//
+// Loading a chunk written by an older version, missing the fields added
+// later, leaves those fields untouched.
+type Chunk struct {
+	loading bool
+	data    []uint8
+	pos     int
+}
+
+func (c *Chunk) Loading() bool {
+	return c.loading
+}
+
+func (c *Chunk) next(size int) []uint8 {
+	if !c.loading {
+		c.data = append(c.data, make([]uint8, size)...)
+		return c.data[len(c.data)-size:]
+	}
+
+	if c.pos+size > len(c.data) {
+		return nil
+	}
+
+	c.pos += size
+
+	return c.data[c.pos-size : c.pos]
+}
+
+func (c *Chunk) Uint8(v *uint8) {
+	if buf := c.next(1); buf != nil {
+		if c.loading {
+			*v = buf[0]
+		} else {
+			buf[0] = *v
+		}
+	}
+}
+
+func (c *Chunk) Uint16(v *uint16) {
+	if buf := c.next(2); buf != nil {
+		if c.loading {
+			*v = binary.LittleEndian.Uint16(buf)
+		} else {
+			binary.LittleEndian.PutUint16(buf, *v)
+		}
+	}
+}
+
+func (c *Chunk) Uint64(v *uint64) {
+	if buf := c.next(8); buf != nil {
+		if c.loading {
+			*v = binary.LittleEndian.Uint64(buf)
+		} else {
+			binary.LittleEndian.PutUint64(buf, *v)
+		}
+	}
+}
+
+func (c *Chunk) Int(v *int) {
+	u := uint64(int64(*v))
+	c.Uint64(&u)
+	*v = int(int64(u))
+}
+
+func (c *Chunk) Bool(v *bool) {
+	u := uint8(0)
+	if *v {
+		u = 1
+	}
+
+	c.Uint8(&u)
+	*v = u != 0
+}
+
+// Bytes saves a fixed size block of memory, usually an array as v[:].
+func (c *Chunk) Bytes(v []uint8) {
+	if buf := c.next(len(v)); buf != nil {
+		if c.loading {
+			copy(v, buf)
+		} else {
+			copy(buf, v)
+		}
+	}
+}
+
+// Save writes the header followed by a chunk for every entry.
+func Save(w io.Writer, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+
+	header := make([]uint8, 6)
+	copy(header, Magic)
+	binary.LittleEndian.PutUint16(header[4:], Version)
+
+	if _, err := bw.Write(header); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if len(entry.Tag) != 4 {
+			return fmt.Errorf("state: invalid tag %q", entry.Tag)
+		}
+
+		c := &Chunk{}
+		entry.Serializer.SerializeState(c)
+
+		chunkHeader := make([]uint8, 8)
+		copy(chunkHeader, entry.Tag)
+		binary.LittleEndian.PutUint32(chunkHeader[4:], uint32(len(c.data)))
+
+		if _, err := bw.Write(chunkHeader); err != nil {
+			return err
+		}
+
+		if _, err := bw.Write(c.data); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// Load reads a state written by Save, chunks without an entry are skipped and
+// entries without a chunk are left untouched.
+func Load(r io.Reader, entries []Entry) error {
+	br := bufio.NewReader(r)
+
+	header := make([]uint8, 6)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return fmt.Errorf("state: reading header: %v", err)
+	}
+
+	if string(header[:4]) != Magic {
+		return errors.New("state: invalid magic")
+	}
+
+	version := binary.LittleEndian.Uint16(header[4:])
+	if version < MinVersion || version > Version {
+		return fmt.Errorf("state: unsupported version %d", version)
+	}
+
+	chunks := make(map[string][]uint8)
+
+	for {
+		chunkHeader := make([]uint8, 8)
+
+		_, err := io.ReadFull(br, chunkHeader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("state: reading chunk: %v", err)
+		}
+
+		size := binary.LittleEndian.Uint32(chunkHeader[4:])
+		if size > MaxChunkSize {
+			return fmt.Errorf("state: chunk %s too large, %d bytes", chunkHeader[:4], size)
+		}
+
+		data := make([]uint8, size)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return fmt.Errorf("state: reading chunk %s: %v", chunkHeader[:4], err)
+		}
+
+		chunks[string(chunkHeader[:4])] = data
+	}
+
+	for _, entry := range entries {
+		data, ok := chunks[entry.Tag]
+		if !ok {
+			continue
+		}
+
+		entry.Serializer.SerializeState(&Chunk{
+			loading: true,
+			data:    data,
+		})
+	}
+
+	return nil
+}
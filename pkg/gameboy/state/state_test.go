@@ -0,0 +1,86 @@
+package state
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testComponentV1 struct {
+	A uint8
+	B uint16
+}
+
+func (t *testComponentV1) SerializeState(c *Chunk) {
+	c.Uint8(&t.A)
+	c.Uint16(&t.B)
+}
+
+type testComponentV2 struct {
+	testComponentV1
+	C int
+	D bool
+	E [4]uint8
+}
+
+func (t *testComponentV2) SerializeState(c *Chunk) {
+	t.testComponentV1.SerializeState(c)
+	c.Int(&t.C)
+	c.Bool(&t.D)
+	c.Bytes(t.E[:])
+}
+
+func TestSaveLoad(t *testing.T) {
+	src := &testComponentV2{testComponentV1{0x12, 0x3456}, -7, true, [4]uint8{1, 2, 3, 4}}
+
+	var buf bytes.Buffer
+	if err := Save(&buf, []Entry{{"TEST", src}, {"SKIP", src}}); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := &testComponentV2{}
+	if err := Load(bytes.NewReader(buf.Bytes()), []Entry{{"TEST", dst}, {"MISS", dst}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if *dst != *src {
+		t.Errorf("load error: want %+v, got %+v", src, dst)
+	}
+}
+
+func TestLoad_OlderChunk(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Save(&buf, []Entry{{"TEST", &testComponentV1{0x12, 0x3456}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := &testComponentV2{C: 5}
+	if err := Load(&buf, []Entry{{"TEST", dst}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.A != 0x12 || dst.B != 0x3456 || dst.C != 5 {
+		t.Errorf("load error: got %+v", dst)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	if err := Load(bytes.NewReader([]uint8("GBSX\x01\x00")), nil); err == nil {
+		t.Error("magic error: want error, got nil")
+	}
+
+	if err := Load(bytes.NewReader([]uint8("GBST\xff\x00")), nil); err == nil {
+		t.Error("version error: want error, got nil")
+	}
+
+	if err := Load(bytes.NewReader([]uint8("GBST\x01\x00")), nil); err == nil {
+		t.Error("old version error: want error, got nil")
+	}
+
+	if err := Load(bytes.NewReader([]uint8("GBST\x02\x00TEST\x10\x00\x00\x00")), nil); err == nil {
+		t.Error("truncated error: want error, got nil")
+	}
+
+	if err := Load(bytes.NewReader([]uint8("GBST\x02\x00TEST\xff\xff\xff\xff")), nil); err == nil {
+		t.Error("chunk size error: want error, got nil")
+	}
+}
@@ -0,0 +1,233 @@
+package symbols
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// regions are the starts of the memory regions, offsets from a symbol don't
+// cross them
+var regions = []uint16{0x0000, 0x4000, 0x8000, 0xa000, 0xc000, 0xd000, 0xe000, 0xfe00, 0xff00, 0xff80, 0xffff}
+
+func regionStart(addr uint16) uint16 {
+	res := uint16(0)
+
+	for _, start := range regions {
+		if start > addr {
+			break
+		}
+
+		res = start
+	}
+
+	return res
+}
+
+type Symbol struct {
+	Bank int
+	Addr uint16
+	Name string
+}
+
+// Table holds the symbols of a rom, by name and by bank and address.
+type Table struct {
+	byName map[string]Symbol
+	byBank map[int][]Symbol
+}
+
+func NewTable() *Table {
+	return &Table{
+		byName: make(map[string]Symbol),
+		byBank: make(map[int][]Symbol),
+	}
+}
+
+// Parse reads a symbol file in the RGBDS and no$gmb format, a symbol per
+// line as bank:address name, with ; comments:
+//
+//	; File generated by rgblink
+//	00:0150 Main
+//	01:4000 Func.loop
+func Parse(r io.Reader) (*Table, error) {
+	t := NewTable()
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Text()
+		if i := strings.Index(text, ";"); i >= 0 {
+			text = text[:i]
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		parts := strings.SplitN(fields[0], ":", 2)
+		if len(fields) != 2 || len(parts) != 2 {
+			return nil, fmt.Errorf("symbols: line %d: invalid symbol %q", line, text)
+		}
+
+		bank, err := strconv.ParseUint(parts[0], 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("symbols: line %d: invalid bank %q", line, parts[0])
+		}
+
+		addr, err := strconv.ParseUint(parts[1], 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("symbols: line %d: invalid address %q", line, parts[1])
+		}
+
+		t.Add(Symbol{Bank: int(bank), Addr: uint16(addr), Name: fields[1]})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func Load(path string) (*Table, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// LoadForROM loads the symbol file next to the rom, <rom>.sym, it returns
+// nil without error if there is none.
+func LoadForROM(romPath string) (*Table, error) {
+	path := strings.TrimSuffix(romPath, filepath.Ext(romPath)) + ".sym"
+
+	t, err := Load(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return t, err
+}
+
+// Add adds a symbol, the first one added wins among the ones with the same
+// name or address.
+func (t *Table) Add(s Symbol) {
+	if _, ok := t.byName[s.Name]; !ok {
+		t.byName[s.Name] = s
+	}
+
+	syms := t.byBank[s.Bank]
+	i := sort.Search(len(syms), func(i int) bool {
+		return syms[i].Addr > s.Addr
+	})
+
+	syms = append(syms, Symbol{})
+	copy(syms[i+1:], syms[i:])
+	syms[i] = s
+
+	t.byBank[s.Bank] = syms
+}
+
+func (t *Table) Len() int {
+	if t == nil {
+		return 0
+	}
+
+	return len(t.byName)
+}
+
+// Symbols returns all the symbols sorted by bank and address.
+func (t *Table) Symbols() []Symbol {
+	if t == nil {
+		return nil
+	}
+
+	var res []Symbol
+	for _, syms := range t.byBank {
+		res = append(res, syms...)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Bank != res[j].Bank {
+			return res[i].Bank < res[j].Bank
+		}
+
+		return res[i].Addr < res[j].Addr
+	})
+
+	return res
+}
+
+// Lookup returns the symbol with the given name. The methods looking up
+// symbols can be called on a nil table, which has none.
+func (t *Table) Lookup(name string) (Symbol, bool) {
+	if t == nil {
+		return Symbol{}, false
+	}
+
+	s, ok := t.byName[name]
+
+	return s, ok
+}
+
+// Nearest returns the symbol at or before addr in the same bank and memory
+// region.
+func (t *Table) Nearest(bank int, addr uint16) (Symbol, bool) {
+	if t == nil {
+		return Symbol{}, false
+	}
+
+	syms := t.byBank[bank]
+	i := sort.Search(len(syms), func(i int) bool {
+		return syms[i].Addr > addr
+	})
+
+	if i == 0 || syms[i-1].Addr < regionStart(addr) {
+		return Symbol{}, false
+	}
+
+	// the first added among the symbols at the same address
+	res := syms[i-1]
+	for i > 1 && syms[i-2].Addr == res.Addr {
+		i--
+		res = syms[i-1]
+	}
+
+	return res, true
+}
+
+// Name returns the name of the symbol exactly at addr.
+func (t *Table) Name(bank int, addr uint16) (string, bool) {
+	s, ok := t.Nearest(bank, addr)
+	if !ok || s.Addr != addr {
+		return "", false
+	}
+
+	return s.Name, true
+}
+
+// Format returns addr as label+offset, empty if no symbol precedes it.
+func (t *Table) Format(bank int, addr uint16) string {
+	s, ok := t.Nearest(bank, addr)
+	if !ok {
+		return ""
+	}
+
+	if s.Addr == addr {
+		return s.Name
+	}
+
+	return fmt.Sprintf("%s+%X", s.Name, addr-s.Addr)
+}
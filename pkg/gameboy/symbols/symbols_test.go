@@ -0,0 +1,61 @@
+package symbols
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSymbols = `; File generated by rgblink
+00:0150 Main
+00:0150 Entry
+00:0158 Main.loop
+01:4000 Bank1Func
+02:4000 Bank2Func
+00:c000 wCounter
+`
+
+func TestParse(t *testing.T) {
+	table, err := Parse(strings.NewReader(testSymbols))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if table.Len() != 6 {
+		t.Errorf("len error: want 6, got %d", table.Len())
+	}
+
+	if s, ok := table.Lookup("Bank2Func"); !ok || s.Bank != 2 || s.Addr != 0x4000 {
+		t.Errorf("lookup error: want 02:4000, got %v %t", s, ok)
+	}
+
+	tests := []struct {
+		bank int
+		addr uint16
+		want string
+	}{
+		{0, 0x0150, "Main"},
+		{0, 0x0153, "Main+3"},
+		{0, 0x015a, "Main.loop+2"},
+		{1, 0x4010, "Bank1Func+10"},
+		{2, 0x4010, "Bank2Func+10"},
+		{3, 0x4010, ""},
+		{0, 0x0100, ""},
+		{0, 0xc001, "wCounter+1"},
+		// offsets don't cross memory regions
+		{0, 0xd000, ""},
+	}
+
+	for _, test := range tests {
+		if got := table.Format(test.bank, test.addr); got != test.want {
+			t.Errorf("format %02x:%04x error: want %q, got %q", test.bank, test.addr, test.want, got)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, text := range []string{"0150 Main", "00:xyz Main", "00:0150"} {
+		if _, err := Parse(strings.NewReader(text)); err == nil {
+			t.Errorf("parse %q error: want error, got nil", text)
+		}
+	}
+}
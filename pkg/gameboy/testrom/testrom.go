@@ -0,0 +1,235 @@
+package testrom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/hardware/serial"
+)
+
+const (
+	// ProtocolAuto detects the result with any of the known protocols
+	ProtocolAuto = "auto"
+	// ProtocolSerial looks for "Passed" or "Failed" in the text sent on the serial port (blargg)
+	ProtocolSerial = "serial"
+	// ProtocolMemory reads the result code and text written at 0xa000 (blargg)
+	ProtocolMemory = "memory"
+	// ProtocolMooneye checks the fibonacci numbers in the registers after LD B,B (mooneye),
+	// the auto protocol only checks them after the LD B,B with the passed or
+	// failed registers
+	ProtocolMooneye = "mooneye"
+
+	DefaultMaxFrames = 60 * 60
+
+	memoryStatusAddr    = 0xa000
+	memorySignatureAddr = 0xa001
+	memoryTextAddr      = 0xa004
+	memoryRunning       = 0x80
+
+	opCodeLdBB = 0x40
+	// mooneyeFailed is the value of all the registers of a failed mooneye test
+	mooneyeFailed = 0x42
+)
+
+var memorySignature = []uint8{0xde, 0xb0, 0x61}
+
+type Status int
+
+const (
+	StatusPass Status = iota
+	StatusFail
+	StatusTimeout
+	StatusError
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPass:
+		return "PASS"
+	case StatusFail:
+		return "FAIL"
+	case StatusTimeout:
+		return "TIMEOUT"
+	default:
+		return "ERROR"
+	}
+}
+
+type Config struct {
+	Protocol string
+	Bootrom  []uint8
+	// MaxFrames stops the test with a timeout after the given frames, 0 uses DefaultMaxFrames
+	MaxFrames int
+	// MaxCycles stops the test with a timeout after the given cycles, 0 disables it
+	MaxCycles int
+}
+
+type Result struct {
+	Status   Status
+	Message  string
+	Frames   int
+	Cycles   int
+	Duration time.Duration
+}
+
+// Run runs a test rom headlessly until it reports a result or times out.
+func Run(rom []uint8, cfg Config) (res Result) {
+	if cfg.Protocol == "" {
+		cfg.Protocol = ProtocolAuto
+	}
+
+	if cfg.MaxFrames == 0 {
+		cfg.MaxFrames = DefaultMaxFrames
+	}
+
+	start := time.Now()
+
+	defer func() {
+		// the core returns its errors, a panic is a bug but must not stop
+		// the other roms
+		if err := recover(); err != nil {
+			res.Status = StatusError
+			res.Message = fmt.Sprint(err)
+		}
+
+		res.Duration = time.Since(start)
+	}()
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{
+		Bootrom:   cfg.Bootrom,
+		Cartridge: rom,
+	})
+	if err != nil {
+		res.Status = StatusError
+		res.Message = err.Error()
+		return res
+	}
+
+	serialBuffer := serial.NewBuffer()
+	gb.Hardware.SetSerialSink(serialBuffer)
+
+	checkSerial := cfg.Protocol == ProtocolAuto || cfg.Protocol == ProtocolSerial
+	checkMemory := cfg.Protocol == ProtocolAuto || cfg.Protocol == ProtocolMemory
+	checkMooneye := cfg.Protocol == ProtocolAuto || cfg.Protocol == ProtocolMooneye
+
+	maxCyclesPerFrame := gb.ClockSpeed / 60
+
+	for res.Frames < cfg.MaxFrames {
+		frameCycles := 0
+
+		for frameCycles < maxCyclesPerFrame {
+			isLdBB := checkMooneye && !gb.CPU.IsNextInstructionPrefixed && gb.Hardware.Peek(gb.CPU.PC) == opCodeLdBB
+
+			cycles, err := gb.Step()
+			if err != nil {
+				res.Status = StatusError
+				res.Message = err.Error()
+				return res
+			}
+
+			frameCycles += cycles
+			res.Cycles += cycles
+
+			if isLdBB {
+				var ok bool
+				res.Status, res.Message, ok = mooneyeResult(gb, cfg.Protocol == ProtocolMooneye)
+				if ok {
+					return res
+				}
+			}
+
+			if cfg.MaxCycles > 0 && res.Cycles >= cfg.MaxCycles {
+				res.Status = StatusTimeout
+				res.Message = fmt.Sprintf("no result after %d cycles", res.Cycles)
+				return res
+			}
+		}
+
+		res.Frames++
+
+		if checkSerial {
+			if status, ok := serialResult(serialBuffer.String()); ok {
+				res.Status = status
+				res.Message = strings.TrimSpace(serialBuffer.String())
+				return res
+			}
+		}
+
+		if checkMemory {
+			if status, msg, ok := memoryResult(gb); ok {
+				res.Status = status
+				res.Message = msg
+				return res
+			}
+		}
+	}
+
+	res.Status = StatusTimeout
+	res.Message = fmt.Sprintf("no result after %d frames", res.Frames)
+	if text := strings.TrimSpace(serialBuffer.String()); text != "" {
+		res.Message += ": " + text
+	}
+
+	return res
+}
+
+func serialResult(text string) (Status, bool) {
+	switch {
+	case strings.Contains(text, "Passed"):
+		return StatusPass, true
+	case strings.Contains(text, "Failed"):
+		return StatusFail, true
+	default:
+		return StatusPass, false
+	}
+}
+
+func memoryResult(gb *gameboy.GameBoy) (Status, string, bool) {
+	for i, val := range memorySignature {
+		if gb.Hardware.Peek(memorySignatureAddr+uint16(i)) != val {
+			return StatusPass, "", false
+		}
+	}
+
+	code := gb.Hardware.Peek(memoryStatusAddr)
+	if code == memoryRunning {
+		return StatusPass, "", false
+	}
+
+	var text bytes.Buffer
+	for addr := uint16(memoryTextAddr); addr < 0xc000; addr++ {
+		val := gb.Hardware.Peek(addr)
+		if val == 0 {
+			break
+		}
+
+		text.WriteByte(val)
+	}
+
+	msg := strings.TrimSpace(text.String())
+
+	if code != 0 {
+		return StatusFail, fmt.Sprintf("result code %#02x: %s", code, msg), true
+	}
+
+	return StatusPass, msg, true
+}
+
+// mooneyeResult returns the result of a mooneye test at LD B,B, false if the
+// registers aren't a mooneye result unless explicit is set.
+func mooneyeResult(gb *gameboy.GameBoy, explicit bool) (Status, string, bool) {
+	c := gb.CPU
+	regs := fmt.Sprintf("B=%d C=%d D=%d E=%d H=%d L=%d", c.B, c.C, c.D, c.E, c.H, c.L)
+
+	if c.B == 3 && c.C == 5 && c.D == 8 && c.E == 13 && c.H == 21 && c.L == 34 {
+		return StatusPass, regs, true
+	}
+
+	failed := c.B == mooneyeFailed && c.C == mooneyeFailed && c.D == mooneyeFailed &&
+		c.E == mooneyeFailed && c.H == mooneyeFailed && c.L == mooneyeFailed
+
+	return StatusFail, regs, failed || explicit
+}
@@ -0,0 +1,76 @@
+package testrom
+
+import "testing"
+
+// newTestROM returns a rom running code after its header.
+func newTestROM(code ...uint8) []uint8 {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0xc3, 0x50, 0x01})
+	copy(rom[0x0150:], code)
+
+	return rom
+}
+
+func ldA(val uint8) []uint8 {
+	return []uint8{0x3e, val}
+}
+
+func TestRun(t *testing.T) {
+	loop := []uint8{0x18, 0xfe}
+
+	var serialCode []uint8
+	for _, val := range []uint8("Passed") {
+		serialCode = append(serialCode, ldA(val)...)
+		serialCode = append(serialCode, 0xe0, 0x01)
+		serialCode = append(serialCode, ldA(0x81)...)
+		serialCode = append(serialCode, 0xe0, 0x02)
+		// wait for the end of the transfer, LDH A,($02) BIT 7,A JR NZ
+		serialCode = append(serialCode, 0xf0, 0x02, 0xcb, 0x7f, 0x20, 0xfa)
+	}
+
+	var memoryCode []uint8
+	for i, val := range []uint8{0x80, 0xde, 0xb0, 0x61, 'o', 'k', 0x00, 0x01} {
+		addr := uint16(0xa000 + i)
+		if i == 7 {
+			addr = 0xa000
+		}
+
+		memoryCode = append(memoryCode, ldA(val)...)
+		memoryCode = append(memoryCode, 0xea, uint8(addr), uint8(addr>>8))
+	}
+
+	tests := []struct {
+		name     string
+		code     []uint8
+		protocol string
+		want     Status
+	}{
+		{"mooneye pass", []uint8{0x06, 3, 0x0e, 5, 0x16, 8, 0x1e, 13, 0x26, 21, 0x2e, 34, 0x40}, ProtocolAuto, StatusPass},
+		{"mooneye fail", []uint8{0x06, 0x42, 0x0e, 0x42, 0x16, 0x42, 0x1e, 0x42, 0x26, 0x42, 0x2e, 0x42, 0x40}, ProtocolMooneye, StatusFail},
+		{"mooneye other", []uint8{0x06, 0x42, 0x40}, ProtocolMooneye, StatusFail},
+		{"ld b,b ignored", []uint8{0x06, 0x42, 0x40}, ProtocolAuto, StatusTimeout},
+		{"serial pass", serialCode, ProtocolSerial, StatusPass},
+		{"memory fail", memoryCode, ProtocolMemory, StatusFail},
+		{"timeout", nil, ProtocolAuto, StatusTimeout},
+		{"serial ignored", serialCode, ProtocolMooneye, StatusTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rom := newTestROM(append(tt.code, loop...)...)
+
+			res := Run(rom, Config{Protocol: tt.protocol, MaxFrames: 2})
+			if res.Status != tt.want {
+				t.Errorf("status error: want %s, got %s (%s)", tt.want, res.Status, res.Message)
+			}
+		})
+	}
+}
+
+func TestRun_MaxCycles(t *testing.T) {
+	res := Run(newTestROM(0x18, 0xfe), Config{MaxCycles: 1000})
+
+	if res.Status != StatusTimeout || res.Frames != 0 {
+		t.Errorf("timeout error: status %s, frames %d", res.Status, res.Frames)
+	}
+}
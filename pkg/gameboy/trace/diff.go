@@ -0,0 +1,287 @@
+package trace
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Field is a NAME:VALUE field of a trace line.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// ParseLine returns the fields of a trace line, comments after ; and the
+// words without a colon are ignored.
+func ParseLine(text string) []Field {
+	if i := strings.Index(text, ";"); i >= 0 {
+		text = text[:i]
+	}
+
+	var res []Field
+
+	for _, word := range strings.Fields(text) {
+		i := strings.Index(word, ":")
+		if i <= 0 || i == len(word)-1 {
+			continue
+		}
+
+		res = append(res, Field{
+			Name:  strings.ToUpper(word[:i]),
+			Value: strings.ToUpper(word[i+1:]),
+		})
+	}
+
+	return res
+}
+
+func fieldValue(fields []Field, name string) (string, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f.Value, true
+		}
+	}
+
+	return "", false
+}
+
+var flagNames = []string{"Z", "N", "H", "C"}
+
+// CompareFields describes the differences between the fields present in both
+// lines, the fields only in one of them, as cycle counts, are optional.
+func CompareFields(a []Field, b []Field, ignore map[string]bool) []string {
+	var res []string
+
+	for _, fa := range a {
+		if ignore[fa.Name] {
+			continue
+		}
+
+		vb, ok := fieldValue(b, fa.Name)
+		if !ok || vb == fa.Value {
+			continue
+		}
+
+		if fa.Name == "F" {
+			if desc := compareFlags(fa.Value, vb); desc != "" {
+				res = append(res, desc)
+				continue
+			}
+		}
+
+		res = append(res, fmt.Sprintf("%s: %s != %s", fa.Name, fa.Value, vb))
+	}
+
+	return res
+}
+
+func compareFlags(a string, b string) string {
+	fa, errA := strconv.ParseUint(a, 16, 8)
+	fb, errB := strconv.ParseUint(b, 16, 8)
+	if errA != nil || errB != nil {
+		return ""
+	}
+
+	var flags []string
+	for i, name := range flagNames {
+		bit := uint(7 - i)
+		if (fa>>bit)&1 != (fb>>bit)&1 {
+			flags = append(flags, fmt.Sprintf("%s %d != %d", name, (fa>>bit)&1, (fb>>bit)&1))
+		}
+	}
+
+	if len(flags) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("F: %s != %s (flag %s)", a, b, strings.Join(flags, ", flag "))
+}
+
+// Line is a line of a trace log.
+type Line struct {
+	Number int
+	Text   string
+}
+
+// DiffConfig configures the comparison of two logs.
+type DiffConfig struct {
+	// AlignPC skips the lines before the first one at this address in both
+	// logs, as the bootrom, when HasAlignPC is set
+	AlignPC    uint16
+	HasAlignPC bool
+	// Context is the number of lines shown before and after the difference
+	Context int
+	// Ignore are the names of the fields not compared
+	Ignore []string
+}
+
+// DiffResult is the first difference between two logs, Differences is empty
+// if the logs are equal.
+type DiffResult struct {
+	// Compared is the number of lines compared
+	Compared    int
+	Differences []string
+	A           Line
+	B           Line
+	Before      []Line
+	AfterA      []Line
+	AfterB      []Line
+}
+
+type logReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func newLogReader(r io.Reader) *logReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	return &logReader{scanner: scanner}
+}
+
+// next returns the next non empty line, false at the end of the log.
+func (l *logReader) next() (Line, bool) {
+	for l.scanner.Scan() {
+		l.line++
+
+		if text := strings.TrimSpace(l.scanner.Text()); text != "" {
+			return Line{Number: l.line, Text: text}, true
+		}
+	}
+
+	return Line{}, false
+}
+
+// align skips the lines before the first one at pc.
+func (l *logReader) align(pc uint16) (Line, bool) {
+	want := fmt.Sprintf("%04X", pc)
+
+	for {
+		line, ok := l.next()
+		if !ok {
+			return line, false
+		}
+
+		if val, ok := fieldValue(ParseLine(line.Text), "PC"); ok && val == want {
+			return line, true
+		}
+	}
+}
+
+func (l *logReader) err() error {
+	return l.scanner.Err()
+}
+
+// alignError returns the error of the readers, or an error naming the logs
+// without a line at pc.
+func alignError(ra, rb *logReader, okA, okB bool, pc uint16) error {
+	if err := ra.err(); err != nil {
+		return err
+	}
+
+	if err := rb.err(); err != nil {
+		return err
+	}
+
+	switch {
+	case !okA && !okB:
+		return fmt.Errorf("trace: no line at PC %04X in both logs", pc)
+	case !okA:
+		return fmt.Errorf("trace: no line at PC %04X in the first log", pc)
+	case !okB:
+		return fmt.Errorf("trace: no line at PC %04X in the second log", pc)
+	}
+
+	return nil
+}
+
+// Diff compares two logs line by line and returns the first difference,
+// keeping in memory only the context lines. It returns an error if a log has
+// no line at the alignment PC or if there are no lines to compare.
+func Diff(a io.Reader, b io.Reader, cfg DiffConfig) (*DiffResult, error) {
+	ra, rb := newLogReader(a), newLogReader(b)
+
+	ignore := make(map[string]bool)
+	for _, name := range cfg.Ignore {
+		ignore[strings.ToUpper(name)] = true
+	}
+
+	res := &DiffResult{}
+
+	var la, lb Line
+	var okA, okB bool
+
+	if cfg.HasAlignPC {
+		la, okA = ra.align(cfg.AlignPC)
+		lb, okB = rb.align(cfg.AlignPC)
+
+		if err := alignError(ra, rb, okA, okB, cfg.AlignPC); err != nil {
+			return nil, err
+		}
+	} else {
+		la, okA = ra.next()
+		lb, okB = rb.next()
+	}
+
+	for okA && okB {
+		res.Differences = CompareFields(ParseLine(la.Text), ParseLine(lb.Text), ignore)
+		if len(res.Differences) > 0 {
+			break
+		}
+
+		res.Compared++
+
+		if cfg.Context > 0 {
+			if len(res.Before) == cfg.Context {
+				copy(res.Before, res.Before[1:])
+				res.Before = res.Before[:cfg.Context-1]
+			}
+
+			res.Before = append(res.Before, la)
+		}
+
+		la, okA = ra.next()
+		lb, okB = rb.next()
+	}
+
+	if err := ra.err(); err != nil {
+		return nil, err
+	}
+
+	if err := rb.err(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case okA && !okB:
+		res.Differences = []string{fmt.Sprintf("second log ended at line %d", rb.line)}
+	case !okA && okB:
+		res.Differences = []string{fmt.Sprintf("first log ended at line %d", ra.line)}
+	case !okA && !okB:
+		if res.Compared == 0 {
+			return nil, errors.New("trace: no lines to compare")
+		}
+
+		res.Before = nil
+		return res, nil
+	}
+
+	res.A, res.B = la, lb
+
+	for i := 0; i < cfg.Context; i++ {
+		if line, ok := ra.next(); ok {
+			res.AfterA = append(res.AfterA, line)
+		}
+
+		if line, ok := rb.next(); ok {
+			res.AfterB = append(res.AfterB, line)
+		}
+	}
+
+	return res, nil
+}
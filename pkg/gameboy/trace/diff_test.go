@@ -0,0 +1,123 @@
+package trace
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	fields := ParseLine("a:01 f:b0 PC:0100 (cycles) CY:1234 ; Boot")
+
+	want := []Field{{"A", "01"}, {"F", "B0"}, {"PC", "0100"}, {"CY", "1234"}}
+	if len(fields) != len(want) {
+		t.Fatalf("fields error: want %v, got %v", want, fields)
+	}
+
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("field %d error: want %v, got %v", i, want[i], fields[i])
+		}
+	}
+}
+
+func TestCompareFields(t *testing.T) {
+	a := ParseLine("A:01 F:B0 B:00 PC:0100 CY:10")
+	b := ParseLine("A:02 F:90 B:00 PC:0100")
+
+	diffs := CompareFields(a, b, nil)
+	want := []string{"A: 01 != 02", "F: B0 != 90 (flag H 1 != 0)"}
+
+	if strings.Join(diffs, "\n") != strings.Join(want, "\n") {
+		t.Errorf("differences error: want %q, got %q", want, diffs)
+	}
+
+	if diffs := CompareFields(a, b, map[string]bool{"A": true, "F": true}); len(diffs) != 0 {
+		t.Errorf("ignored differences error: got %q", diffs)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	ours := strings.Join([]string{
+		"A:01 F:B0 PC:0100",
+		"A:02 F:10 PC:0101",
+		"A:02 F:10 PC:0104",
+		"A:03 F:10 PC:0100",
+		"A:04 F:10 PC:0101",
+	}, "\n")
+
+	// the reference includes the bootrom and cycle counts
+	reference := strings.Join([]string{
+		"A:00 F:00 PC:0000 CY:0",
+		"A:00 F:00 PC:0003 CY:12",
+		"",
+		"A:01 F:B0 PC:0100 CY:100",
+		"A:02 F:10 PC:0101 CY:104",
+		"A:02 F:10 PC:0104 CY:120",
+		"A:03 F:90 PC:0100 CY:132",
+		"A:04 F:90 PC:0101 CY:136",
+	}, "\n")
+
+	res, err := Diff(strings.NewReader(ours), strings.NewReader(reference), DiffConfig{
+		AlignPC:    0x0100,
+		HasAlignPC: true,
+		Context:    2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Compared != 3 {
+		t.Errorf("compared error: want 3, got %d", res.Compared)
+	}
+
+	if len(res.Differences) != 1 || res.Differences[0] != "F: 10 != 90 (flag Z 0 != 1)" {
+		t.Errorf("differences error: got %q", res.Differences)
+	}
+
+	if res.A.Number != 4 || res.B.Number != 7 {
+		t.Errorf("lines error: want 4 and 7, got %d and %d", res.A.Number, res.B.Number)
+	}
+
+	if len(res.Before) != 2 || res.Before[0].Number != 2 || res.Before[1].Number != 3 {
+		t.Errorf("context before error: got %v", res.Before)
+	}
+
+	if len(res.AfterA) != 1 || len(res.AfterB) != 1 || res.AfterB[0].Number != 8 {
+		t.Errorf("context after error: got %v and %v", res.AfterA, res.AfterB)
+	}
+}
+
+func TestDiff_End(t *testing.T) {
+	ours := "A:01 PC:0100\nA:02 PC:0101\n"
+	reference := "A:01 PC:0100\nA:02 PC:0101\nA:03 PC:0102\n"
+
+	res, err := Diff(strings.NewReader(ours), strings.NewReader(ours), DiffConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Differences) != 0 || res.Compared != 2 {
+		t.Errorf("equal logs error: got %d lines compared, differences %q", res.Compared, res.Differences)
+	}
+
+	res, err = Diff(strings.NewReader(ours), strings.NewReader(reference), DiffConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Differences) != 1 || res.Differences[0] != "first log ended at line 2" {
+		t.Errorf("shorter log error: got %q", res.Differences)
+	}
+}
+
+func TestDiff_Invalid(t *testing.T) {
+	log := "A:01 PC:0100\nA:02 PC:0101\n"
+
+	if _, err := Diff(strings.NewReader(log), strings.NewReader("A:01 PC:0000\n"), DiffConfig{AlignPC: 0x0100, HasAlignPC: true}); err == nil {
+		t.Error("align error: want error, got nil")
+	}
+
+	if _, err := Diff(strings.NewReader(""), strings.NewReader("\n"), DiffConfig{}); err == nil {
+		t.Error("empty error: want error, got nil")
+	}
+}
@@ -0,0 +1,128 @@
+package trace
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+// Condition is met at the start of a frame or at an address, written as
+// frame:N or pc:XXXX.
+type Condition struct {
+	IsPC  bool
+	Frame int
+	PC    uint16
+}
+
+func ParseCondition(s string) (*Condition, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("trace: invalid condition %q", s)
+	}
+
+	switch parts[0] {
+	case "frame":
+		frame, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("trace: invalid frame %q", parts[1])
+		}
+
+		return &Condition{Frame: frame}, nil
+	case "pc":
+		pc, err := strconv.ParseUint(strings.TrimPrefix(parts[1], "$"), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("trace: invalid address %q", parts[1])
+		}
+
+		return &Condition{IsPC: true, PC: uint16(pc)}, nil
+	default:
+		return nil, fmt.Errorf("trace: invalid condition %q", s)
+	}
+}
+
+func (c *Condition) Met(gb *gameboy.GameBoy) bool {
+	if c.IsPC {
+		return gb.CPU.PC == c.PC
+	}
+
+	return gb.Frame >= c.Frame
+}
+
+type Config struct {
+	// Start starts tracing when met, at the first instruction if nil
+	Start *Condition
+	// Stop stops tracing when met, never if nil
+	Stop *Condition
+	// MinPC and MaxPC limit the traced instructions, all if MaxPC is 0
+	MinPC uint16
+	MaxPC uint16
+	// Symbols, if set, are appended to the lines as comments
+	Symbols *symbols.Table
+}
+
+// Tracer writes a line per instruction in the Gameboy Doctor format, with the
+// registers and the memory at PC before the instruction is executed:
+//
+//	A:01 F:B0 B:00 C:13 D:00 E:D8 H:01 L:4D SP:FFFE PC:0100 PCMEM:00,C3,13,02
+type Tracer struct {
+	Config
+	writer  *bufio.Writer
+	started bool
+	stopped bool
+}
+
+func NewTracer(w io.Writer, cfg Config) *Tracer {
+	if cfg.MaxPC == 0 {
+		cfg.MaxPC = 0xffff
+	}
+
+	return &Tracer{
+		Config: cfg,
+		writer: bufio.NewWriter(w),
+	}
+}
+
+func (t *Tracer) Trace(gb *gameboy.GameBoy) {
+	if t.stopped {
+		return
+	}
+
+	if !t.started {
+		if t.Start != nil && !t.Start.Met(gb) {
+			return
+		}
+
+		t.started = true
+	}
+
+	if t.Stop != nil && t.Stop.Met(gb) {
+		t.stopped = true
+		t.writer.Flush()
+		return
+	}
+
+	c := gb.CPU
+	if c.PC < t.MinPC || c.PC > t.MaxPC {
+		return
+	}
+
+	fmt.Fprintf(t.writer, "A:%02X F:%02X B:%02X C:%02X D:%02X E:%02X H:%02X L:%02X SP:%04X PC:%04X PCMEM:%02X,%02X,%02X,%02X",
+		c.A, c.F.Read(), c.B, c.C, c.D, c.E, c.H, c.L, c.SP, c.PC,
+		gb.Hardware.Peek(c.PC), gb.Hardware.Peek(c.PC+1), gb.Hardware.Peek(c.PC+2), gb.Hardware.Peek(c.PC+3))
+
+	if name := t.Symbols.Format(gb.Hardware.Bank(c.PC), c.PC); name != "" {
+		fmt.Fprintf(t.writer, " ; %s", name)
+	}
+
+	t.writer.WriteByte('\n')
+}
+
+// Flush writes the buffered lines, it must be called when tracing ends.
+func (t *Tracer) Flush() error {
+	return t.writer.Flush()
+}
@@ -0,0 +1,80 @@
+package trace
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/adnsio/gbemu/pkg/gameboy"
+	"github.com/adnsio/gbemu/pkg/gameboy/symbols"
+)
+
+// newTestGameBoy runs at 0x0100 inc a; ld (c000),a; jr 0100
+func newTestGameBoy(t *testing.T) *gameboy.GameBoy {
+	rom := make([]uint8, 0x8000)
+	copy(rom[0x0100:], []uint8{0x3c, 0xea, 0x00, 0xc0, 0x18, 0xfa})
+
+	gb, err := gameboy.NewGameBoy(gameboy.Config{Cartridge: rom})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return gb
+}
+
+func traceLines(gb *gameboy.GameBoy, cfg Config, steps int) []string {
+	var buf bytes.Buffer
+
+	tracer := NewTracer(&buf, cfg)
+	gb.Tracer = tracer
+
+	for i := 0; i < steps; i++ {
+		gb.Step()
+	}
+	tracer.Flush()
+
+	return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+func TestTracer(t *testing.T) {
+	lines := traceLines(newTestGameBoy(t), Config{}, 3)
+
+	want := []string{
+		"A:01 F:B0 B:00 C:13 D:00 E:D8 H:01 L:4D SP:FFFE PC:0100 PCMEM:3C,EA,00,C0",
+		"A:02 F:10 B:00 C:13 D:00 E:D8 H:01 L:4D SP:FFFE PC:0101 PCMEM:EA,00,C0,18",
+		"A:02 F:10 B:00 C:13 D:00 E:D8 H:01 L:4D SP:FFFE PC:0104 PCMEM:18,FA,00,00",
+	}
+
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Errorf("trace error: want\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(lines, "\n"))
+	}
+}
+
+func TestTracer_Conditions(t *testing.T) {
+	start, err := ParseCondition("pc:0104")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop, err := ParseCondition("pc:0101")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syms, err := symbols.Parse(strings.NewReader("00:0100 Loop\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := traceLines(newTestGameBoy(t), Config{Start: start, Stop: stop, MinPC: 0x0100, MaxPC: 0x0100, Symbols: syms}, 10)
+
+	// started at 0104, 0104 filtered out, stopped at 0101
+	want := "A:02 F:10 B:00 C:13 D:00 E:D8 H:01 L:4D SP:FFFE PC:0100 PCMEM:3C,EA,00,C0 ; Loop"
+	if len(lines) != 1 || lines[0] != want {
+		t.Errorf("trace error: want %q, got %q", want, lines)
+	}
+
+	if _, err := ParseCondition("line:10"); err == nil {
+		t.Error("parse line:10 error: want error, got nil")
+	}
+}